@@ -0,0 +1,225 @@
+package big
+
+// Stats accumulates summary statistics of a stream of decimals without storing them,
+// updating its mean and variance with Welford's algorithm. The zero value is an empty
+// Stats ready to use.
+//
+// A Stats is not safe for concurrent use. To accumulate statistics from several
+// goroutines, give each its own Stats and combine them with Merge.
+//
+// Arithmetic is carried out with 64 bits more than the largest precision pushed so far,
+// so precision does not grow with the number of values. Pushing NaN poisons the Stats,
+// and every statistic is then that NaN. Infinities are counted apart from finite values,
+// so that, as for the slice functions, the mean of +Inf and 5 is +Inf and the variance
+// of any infinite value is NaN. Like Mean and MaxSlice, the statistics of an empty Stats
+// are 0.
+type Stats struct {
+	count     int64
+	finite    int64
+	posInf    int64
+	negInf    int64
+	mean      Decimal
+	m2        Decimal
+	sum       Decimal
+	min       Decimal
+	max       Decimal
+	nan       *Decimal
+	precision uint
+}
+
+// Push adds a value to the Stats.
+func (s *Stats) Push(value Decimal) {
+	s.count++
+
+	if s.nan != nil {
+		return
+	} else if value.NaN() {
+		s.nan = &value
+		return
+	}
+
+	if s.count == 1 || value.LT(s.min) {
+		s.min = value
+	}
+
+	if s.count == 1 || value.GT(s.max) {
+		s.max = value
+	}
+
+	switch {
+	case value.IsInf(1):
+		s.posInf++
+		return
+	case value.IsInf(-1):
+		s.negInf++
+		return
+	}
+
+	s.finite++
+	s.precision = max(s.precision, value.value().Prec())
+	c := s.context()
+
+	if s.finite == 1 {
+		s.mean, s.m2, s.sum = value, zeroDecimal(), value
+		return
+	}
+
+	delta := c.Sub(value, s.mean)
+	s.mean = c.Add(s.mean, c.Div(delta, NewFromInt64(s.finite)))
+	s.m2 = c.Add(s.m2, c.Mul(delta, c.Sub(value, s.mean)))
+	s.sum = c.Add(s.sum, value)
+}
+
+// Merge adds the values pushed to other to this Stats, as if they had been pushed to it,
+// using the parallel algorithm of Chan, Golub and LeVeque.
+func (s *Stats) Merge(other *Stats) {
+	if other == nil || other.count == 0 {
+		return
+	}
+
+	if s.nan == nil && other.nan != nil {
+		s.nan = other.nan
+	}
+
+	if s.nan != nil {
+		s.count += other.count
+		return
+	} else if s.count == 0 {
+		*s = *other
+		return
+	}
+
+	if other.min.LT(s.min) {
+		s.min = other.min
+	}
+
+	if other.max.GT(s.max) {
+		s.max = other.max
+	}
+
+	s.count += other.count
+	s.posInf += other.posInf
+	s.negInf += other.negInf
+
+	if other.finite == 0 {
+		return
+	} else if s.finite == 0 {
+		s.finite, s.mean, s.m2, s.sum, s.precision = other.finite, other.mean, other.m2, other.sum, other.precision
+		return
+	}
+
+	s.precision = max(s.precision, other.precision)
+	c := s.context()
+
+	finite := s.finite + other.finite
+	n, otherN, total := NewFromInt64(s.finite), NewFromInt64(other.finite), NewFromInt64(finite)
+
+	// mean = meanA + δ × nB / n, and m2 = m2A + m2B + δ² × nA × nB / n.
+	delta := c.Sub(other.mean, s.mean)
+	s.mean = c.Add(s.mean, c.Div(c.Mul(delta, otherN), total))
+	s.m2 = c.Add(c.Add(s.m2, other.m2), c.Div(c.Mul(c.Mul(delta, delta), c.Mul(n, otherN)), total))
+	s.sum = c.Add(s.sum, other.sum)
+	s.finite = finite
+}
+
+// Count returns the number of values pushed to the Stats, including any NaN or infinity.
+func (s *Stats) Count() int64 {
+	return s.count
+}
+
+// Sum returns the sum of the values pushed to the Stats.
+func (s *Stats) Sum() Decimal {
+	return s.average("Sum", s.sum)
+}
+
+// Mean returns the arithmetic mean of the values pushed to the Stats.
+func (s *Stats) Mean() Decimal {
+	return s.average("Mean", s.mean)
+}
+
+// Min returns the smallest value pushed to the Stats.
+func (s *Stats) Min() Decimal {
+	return s.statistic(s.min)
+}
+
+// Max returns the largest value pushed to the Stats.
+func (s *Stats) Max() Decimal {
+	return s.statistic(s.max)
+}
+
+// Variance returns the population variance of the values pushed to the Stats.
+func (s *Stats) Variance() Decimal {
+	return s.variance("Variance", 0)
+}
+
+// SampleVariance returns the sample variance of the values pushed to the Stats, which is
+// NaN for a single value.
+func (s *Stats) SampleVariance() Decimal {
+	return s.variance("SampleVariance", 1)
+}
+
+// StdDev returns the population standard deviation of the values pushed to the Stats.
+func (s *Stats) StdDev() Decimal {
+	return s.context().Sqrt(s.Variance())
+}
+
+// SampleStdDev returns the sample standard deviation of the values pushed to the Stats.
+func (s *Stats) SampleStdDev() Decimal {
+	return s.context().Sqrt(s.SampleVariance())
+}
+
+func (s *Stats) variance(op string, ddof int64) Decimal {
+	switch {
+	case s.nan != nil:
+		return *s.nan
+	case s.count == 0:
+		return zeroDecimal()
+	case s.count <= ddof:
+		return nanDecimal(ErrDomain, op, s.min)
+	case s.posInf > 0 || s.negInf > 0:
+		return nanDecimal(ErrIndeterminate, op, s.min, s.max)
+	}
+
+	return s.context().Div(s.m2, NewFromInt64(s.count-ddof))
+}
+
+// average returns value, the sum or mean of the finite values, or the infinity or NaN
+// the infinite values sum to.
+func (s *Stats) average(op string, value Decimal) Decimal {
+	switch {
+	case s.nan != nil:
+		return *s.nan
+	case s.count == 0:
+		return zeroDecimal()
+	case s.posInf > 0 && s.negInf > 0:
+		return nanDecimal(ErrIndeterminate, op, PosInf(), NegInf())
+	case s.posInf > 0:
+		return PosInf()
+	case s.negInf > 0:
+		return NegInf()
+	}
+
+	return value
+}
+
+func (s *Stats) statistic(value Decimal) Decimal {
+	switch {
+	case s.nan != nil:
+		return *s.nan
+	case s.count == 0:
+		return zeroDecimal()
+	}
+
+	return value
+}
+
+func (s *Stats) context() Context {
+	return boundedContext(s.precision)
+}
+
+// boundedContext returns a Context that rounds to guardPrecision bits more than the
+// given precision, and at least minPrecision, so that running statistics do not grow in
+// precision with every value.
+func boundedContext(precision uint) Context {
+	return Context{Precision: max(precision, minPrecision) + guardPrecision, Mode: DefaultContext.Mode}
+}
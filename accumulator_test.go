@@ -0,0 +1,175 @@
+package big
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func pushAll(s *Stats, decimals ...Decimal) *Stats {
+	for _, d := range decimals {
+		s.Push(d)
+	}
+
+	return s
+}
+
+func TestStats(t *testing.T) {
+	data := decimalsFromInts(2, 4, 4, 4, 5, 5, 7, 9)
+	s := pushAll(new(Stats), data...)
+
+	assert.EqualValues(t, 8, s.Count())
+
+	validateEqExamples(t,
+		equalExample{
+			value:    s.Sum(),
+			expected: "40",
+		},
+		equalExample{
+			value:    s.Mean(),
+			expected: "5",
+		},
+		equalExample{
+			value:    s.Min(),
+			expected: "2",
+		},
+		equalExample{
+			value:    s.Max(),
+			expected: "9",
+		},
+		equalExample{
+			value:    s.Variance(),
+			expected: "4",
+		},
+		equalExample{
+			value:    s.StdDev(),
+			expected: "2",
+		},
+	)
+
+	assertDigits(t, "4.5714285714285714285714285714285714285714285714285714285714285714285714", s.SampleVariance(), 70)
+	assertDigits(t, "2.1380899352993950774764278470380281724320113187307011121735688384685915178896795", s.SampleStdDev(), 70)
+
+	t.Run("matches two-pass statistics", func(t *testing.T) {
+		values := decimalsFromStrings("101.25", "99.5", "100.75", "102.125", "98.875", "100.5", "101.625", "99.25")
+		s := pushAll(new(Stats), values...)
+
+		assertDigits(t, Mean(values...).String(), s.Mean(), 70)
+		assertDigits(t, Variance(values...).String(), s.Variance(), 70)
+		assertDigits(t, SampleVariance(values...).String(), s.SampleVariance(), 70)
+		assert.True(t, s.Sum().EQ(Sum(values...)))
+	})
+
+	t.Run("bounded precision", func(t *testing.T) {
+		s := new(Stats)
+		for i := 0; i < 1000; i++ {
+			s.Push(NewFromString("0.1"))
+		}
+
+		assert.LessOrEqual(t, s.Sum().Precision(), 256+guardPrecision)
+		assert.LessOrEqual(t, s.Mean().Precision(), 256+guardPrecision)
+		assertDigits(t, "100", s.Sum(), 70)
+		assertDigits(t, "0.1", s.Mean(), 70)
+	})
+}
+
+func TestStats_Empty(t *testing.T) {
+	var s Stats
+
+	assert.EqualValues(t, 0, s.Count())
+	for _, statistic := range []Decimal{s.Sum(), s.Mean(), s.Min(), s.Max(), s.Variance(), s.SampleVariance(), s.StdDev()} {
+		assert.True(t, statistic.IsZero())
+	}
+
+	s.Push(NewFromString("-3.5"))
+
+	assert.EqualValues(t, "-3.5", s.Min().String())
+	assert.EqualValues(t, "-3.5", s.Max().String())
+	assert.EqualValues(t, "0", s.Variance().String())
+	assert.True(t, errors.Is(s.SampleVariance().NaNReason(), ErrDomain))
+}
+
+func TestStats_NaN(t *testing.T) {
+	s := pushAll(new(Stats), ONE, NaN, TEN)
+
+	assert.EqualValues(t, 3, s.Count())
+	for _, statistic := range []Decimal{s.Sum(), s.Mean(), s.Min(), s.Max(), s.Variance(), s.SampleStdDev()} {
+		assert.True(t, statistic.NaN())
+	}
+
+	poisoned := ONE.Div(ZERO.Sub(ZERO)).Add(ZERO.Div(ZERO))
+	s = pushAll(new(Stats), poisoned)
+	assert.True(t, errors.Is(s.Mean().NaNReason(), ErrDivisionByZero))
+}
+
+func TestStats_Merge(t *testing.T) {
+	values := decimalsFromStrings("101.25", "99.5", "100.75", "102.125", "98.875", "100.5", "101.625", "99.25", "-4", "250.5")
+	whole := pushAll(new(Stats), values...)
+
+	for split := 0; split <= len(values); split++ {
+		a := pushAll(new(Stats), values[:split]...)
+		b := pushAll(new(Stats), values[split:]...)
+		a.Merge(b)
+
+		assert.EqualValues(t, whole.Count(), a.Count())
+		assert.True(t, a.Sum().EQ(whole.Sum()), "split at %d", split)
+		assert.True(t, a.Min().EQ(whole.Min()), "split at %d", split)
+		assert.True(t, a.Max().EQ(whole.Max()), "split at %d", split)
+		assertDigits(t, whole.Mean().String(), a.Mean(), 70)
+		assertDigits(t, whole.Variance().String(), a.Variance(), 70)
+	}
+
+	t.Run("nil and NaN", func(t *testing.T) {
+		s := pushAll(new(Stats), ONE, TEN)
+		s.Merge(nil)
+		s.Merge(new(Stats))
+
+		assert.EqualValues(t, 2, s.Count())
+		assert.EqualValues(t, "5.5", s.Mean().String())
+
+		s.Merge(pushAll(new(Stats), NaN))
+
+		assert.EqualValues(t, 3, s.Count())
+		assert.True(t, s.Mean().NaN())
+	})
+}
+
+func TestStats_Inf(t *testing.T) {
+	s := pushAll(new(Stats), PosInf(), NewFromInt(5), NewFromInt(-3))
+
+	assert.EqualValues(t, 3, s.Count())
+	assert.True(t, s.Mean().IsInf(1))
+	assert.True(t, s.Sum().IsInf(1))
+	assert.True(t, s.Max().IsInf(1))
+	assert.EqualValues(t, "-3", s.Min().String())
+	assert.True(t, errors.Is(s.Variance().NaNReason(), ErrIndeterminate))
+	assert.True(t, s.Mean().EQ(Mean(PosInf(), NewFromInt(5), NewFromInt(-3))))
+
+	s.Push(NegInf())
+	assert.True(t, errors.Is(s.Mean().NaNReason(), ErrIndeterminate))
+	assert.True(t, s.Min().IsInf(-1))
+
+	s = pushAll(new(Stats), NegInf())
+	assert.True(t, s.Mean().IsInf(-1))
+	assert.True(t, errors.Is(s.SampleVariance().NaNReason(), ErrDomain))
+
+	t.Run("merge", func(t *testing.T) {
+		a := pushAll(new(Stats), NewFromInt(5), NewFromInt(7))
+		a.Merge(pushAll(new(Stats), PosInf()))
+
+		assert.EqualValues(t, 3, a.Count())
+		assert.True(t, a.Mean().IsInf(1))
+		assert.True(t, a.Max().IsInf(1))
+		assert.EqualValues(t, "5", a.Min().String())
+
+		b := pushAll(new(Stats), PosInf())
+		b.Merge(pushAll(new(Stats), NewFromInt(5), NewFromInt(7)))
+
+		assert.True(t, b.Mean().IsInf(1))
+		assert.EqualValues(t, "5", b.Min().String())
+
+		b.Merge(pushAll(new(Stats), NegInf()))
+		assert.True(t, errors.Is(b.Sum().NaNReason(), ErrIndeterminate))
+	})
+}
@@ -0,0 +1,135 @@
+package big
+
+import (
+	"math/big"
+	"sort"
+)
+
+// Allocate divides this Decimal into parts proportional to the given ratios, each
+// rounded to the given number of decimal places, such that the parts sum exactly to this
+// Decimal rounded to that many places, as by Round.
+//
+// Each part is first rounded toward zero, and the units left over are then given one at a
+// time to the parts with the largest remainders, with ties going to the earlier part
+// (the largest remainder method). Ratios must be finite and non-negative, and at least
+// one must be positive; otherwise, as when this Decimal is NaN or infinite, every part is
+// NaN. Allocate returns nil when no ratios are given.
+func (d Decimal) Allocate(places int, ratios ...Decimal) []Decimal {
+	if len(ratios) == 0 {
+		return nil
+	}
+
+	if nan, ok := firstNaN(append([]Decimal{d}, ratios...)...); ok {
+		return repeatDecimal(nan, len(ratios))
+	}
+
+	weights, ok := allocationWeights(ratios)
+	if !ok || d.IsInf(0) {
+		return repeatDecimal(nanDecimal(ErrDomain, "Allocate", append([]Decimal{d}, ratios...)...), len(ratios))
+	}
+
+	coef, exp := d.Round(places).decimalParts()
+	units := coef.Mul(coef, pow10(exp+places))
+
+	parts := allocateUnits(new(big.Int).Abs(units), weights)
+
+	result := make([]Decimal, len(parts))
+	for i, part := range parts {
+		if units.Sign() < 0 {
+			part.Neg(part)
+		}
+
+		result[i] = newFromScaled(part, places)
+	}
+
+	return result
+}
+
+// Split divides this Decimal into n equal parts, each rounded to the given number of
+// decimal places, such that the parts sum exactly to this Decimal rounded to that many
+// places. Split is Allocate with n equal ratios: earlier parts receive the units left
+// over, so 100 split three ways to two places is 33.34, 33.33 and 33.33. Split returns
+// nil when n is not positive.
+func (d Decimal) Split(places, n int) []Decimal {
+	if n <= 0 {
+		return nil
+	}
+
+	return d.Allocate(places, repeatDecimal(oneDecimal(), n)...)
+}
+
+// allocationWeights returns the ratios as integers with the same proportions, and whether
+// the ratios are valid.
+func allocationWeights(ratios []Decimal) ([]*big.Int, bool) {
+	coefs := make([]*big.Int, len(ratios))
+	exps := make([]int, len(ratios))
+
+	positive := false
+	for i, ratio := range ratios {
+		if !ratio.finite() || ratio.Sign() < 0 {
+			return nil, false
+		}
+
+		positive = positive || ratio.Sign() > 0
+		coefs[i], exps[i] = ratio.decimalParts()
+	}
+
+	if !positive {
+		return nil, false
+	}
+
+	minExp := exps[0]
+	for _, exp := range exps {
+		minExp = min(minExp, exp)
+	}
+
+	for i, coef := range coefs {
+		coef.Mul(coef, pow10(exps[i]-minExp))
+	}
+
+	return coefs, true
+}
+
+// allocateUnits divides the non-negative integer units in proportion to the weights using
+// the largest remainder method.
+func allocateUnits(units *big.Int, weights []*big.Int) []*big.Int {
+	total := new(big.Int)
+	for _, weight := range weights {
+		total.Add(total, weight)
+	}
+
+	parts := make([]*big.Int, len(weights))
+	remainders := make([]*big.Int, len(weights))
+	left := new(big.Int).Set(units)
+
+	for i, weight := range weights {
+		parts[i], remainders[i] = new(big.Int).QuoRem(new(big.Int).Mul(units, weight), total, new(big.Int))
+		left.Sub(left, parts[i])
+	}
+
+	order := make([]int, len(weights))
+	for i := range order {
+		order[i] = i
+	}
+
+	sort.SliceStable(order, func(a, b int) bool {
+		return remainders[order[a]].Cmp(remainders[order[b]]) > 0
+	})
+
+	// The units left over are fewer than the number of parts with a remainder.
+	for i := 0; left.Sign() > 0; i++ {
+		parts[order[i]].Add(parts[order[i]], big.NewInt(1))
+		left.Sub(left, big.NewInt(1))
+	}
+
+	return parts
+}
+
+func repeatDecimal(d Decimal, n int) []Decimal {
+	decimals := make([]Decimal, n)
+	for i := range decimals {
+		decimals[i] = d
+	}
+
+	return decimals
+}
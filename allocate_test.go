@@ -0,0 +1,108 @@
+package big
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func decimalStrings(decimals []Decimal) []string {
+	strs := make([]string, len(decimals))
+	for i, d := range decimals {
+		strs[i] = d.String()
+	}
+
+	return strs
+}
+
+func decimalsFromStrings(strs ...string) []Decimal {
+	decimals := make([]Decimal, len(strs))
+	for i, s := range strs {
+		decimals[i] = NewFromString(s)
+	}
+
+	return decimals
+}
+
+func TestDecimal_Allocate(t *testing.T) {
+	examples := []struct {
+		value    string
+		places   int
+		ratios   []string
+		expected []string
+	}{
+		{"100", 2, []string{"1", "1", "1"}, []string{"33.34", "33.33", "33.33"}},
+		{"0.05", 2, []string{"3", "7"}, []string{"0.02", "0.03"}},
+		{"100", 2, []string{"0.5", "0.25", "0.25"}, []string{"50", "25", "25"}},
+		{"-100", 2, []string{"1", "1", "1"}, []string{"-33.34", "-33.33", "-33.33"}},
+		{"10", 0, []string{"1", "0", "2"}, []string{"3", "0", "7"}},
+		{"1", 2, []string{"1", "1", "1", "1", "1", "1", "1"}, []string{"0.15", "0.15", "0.14", "0.14", "0.14", "0.14", "0.14"}},
+		{"1234.567", 2, []string{"70", "20", "10"}, []string{"864.2", "246.91", "123.46"}},
+		{"2.5", 0, []string{"1", "1"}, []string{"2", "1"}},
+		{"1000", -2, []string{"1", "2"}, []string{"300", "700"}},
+		{"0", 2, []string{"1", "2"}, []string{"0", "0"}},
+	}
+
+	for _, ex := range examples {
+		value := NewFromString(ex.value)
+		parts := value.Allocate(ex.places, decimalsFromStrings(ex.ratios...)...)
+
+		assert.EqualValues(t, ex.expected, decimalStrings(parts), "%s %v", ex.value, ex.ratios)
+
+		sum := ZERO
+		for _, part := range parts {
+			sum = sum.Add(part)
+		}
+
+		assert.EqualValues(t, value.Round(ex.places).String(), sum.Round(ex.places).String(), "%s %v", ex.value, ex.ratios)
+	}
+
+	assert.Nil(t, ONE.Allocate(2))
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, ratios := range [][]Decimal{{ONE, ONE.Neg()}, {ZERO, ZERO}, {ONE, PosInf()}} {
+			parts := TEN.Allocate(2, ratios...)
+
+			assert.Len(t, parts, len(ratios))
+			for _, part := range parts {
+				assert.True(t, errors.Is(part.NaNReason(), ErrDomain))
+			}
+		}
+
+		assert.True(t, errors.Is(PosInf().Allocate(2, ONE)[0].NaNReason(), ErrDomain))
+		assert.True(t, NaN.Allocate(2, ONE, ONE)[1].NaN())
+		assert.True(t, TEN.Allocate(2, ONE, NaN)[0].NaN())
+	})
+}
+
+func TestDecimal_Split(t *testing.T) {
+	assert.EqualValues(t, []string{"33.34", "33.33", "33.33"}, decimalStrings(NewFromInt(100).Split(2, 3)))
+	assert.EqualValues(t, []string{"0.01", "0.01", "0", "0"}, decimalStrings(NewFromString("0.02").Split(2, 4)))
+	assert.EqualValues(t, []string{"-3.5"}, decimalStrings(NewFromString("-3.5").Split(1, 1)))
+	assert.Nil(t, TEN.Split(2, 0))
+}
+
+func TestMoney_Allocate(t *testing.T) {
+	parts := mustMoney(t, "100", "USD").Allocate(NewFromInt(1), NewFromInt(1), NewFromInt(1))
+
+	var strs []string
+	for _, part := range parts {
+		strs = append(strs, part.String())
+	}
+
+	assert.EqualValues(t, []string{"33.34 USD", "33.33 USD", "33.33 USD"}, strs)
+	assert.Nil(t, mustMoney(t, "100", "USD").Allocate())
+}
+
+func TestMoney_Split(t *testing.T) {
+	parts := mustMoney(t, "1000", "JPY").Split(3)
+
+	var strs []string
+	for _, part := range parts {
+		strs = append(strs, part.String())
+	}
+
+	assert.EqualValues(t, []string{"334 JPY", "333 JPY", "333 JPY"}, strs)
+	assert.Nil(t, mustMoney(t, "1", "JPY").Split(-1))
+}
@@ -0,0 +1,158 @@
+package big
+
+import (
+	"math"
+	"math/big"
+)
+
+// DefaultContext is the Context used by the arithmetic methods on Decimal. Its zero
+// Precision sizes each result from its operands.
+var DefaultContext = Context{Mode: big.ToNearestEven}
+
+// Context controls the precision and rounding mode of arithmetic results.
+//
+// When Precision is zero, results are sized from their operands: sums and quotients
+// keep the precision of their widest operand and products grow to hold every bit of
+// both factors. When Precision is non-zero, every result is rounded to exactly that
+// many bits. MaxPrecision, when non-zero, caps the precision of any result, which keeps
+// memory use bounded in long computation chains.
+type Context struct {
+	Precision    uint
+	MaxPrecision uint
+	Mode         big.RoundingMode
+}
+
+// NewContext creates a new Context whose results are rounded to the given number of
+// bits using the given rounding mode.
+func NewContext(precision uint, mode big.RoundingMode) Context {
+	return Context{Precision: precision, Mode: mode}
+}
+
+// NewContextDigits creates a new Context whose results hold at least the given number
+// of significant decimal digits, rounded using the given rounding mode.
+func NewContextDigits(digits uint, mode big.RoundingMode) Context {
+	return NewContext(digitsPrecision(digits), mode)
+}
+
+// WithMaxPrecision returns a copy of this Context whose results never exceed the given
+// number of bits.
+func (c Context) WithMaxPrecision(maxPrecision uint) Context {
+	c.MaxPrecision = maxPrecision
+	return c
+}
+
+// Add returns the sum of x and y.
+func (c Context) Add(x, y Decimal) Decimal {
+	return nanGuard(func() Decimal {
+		if x.IsInf(0) && y.IsInf(0) && x.Sign() != y.Sign() {
+			return nanDecimal(ErrIndeterminate, "Add", x, y)
+		}
+
+		return Decimal{fl: c.newFloat(maxPrecision(x, y)+1).Add(x.value(), y.value())}
+	}, x, y)
+}
+
+// Sub returns the difference of x and y.
+func (c Context) Sub(x, y Decimal) Decimal {
+	return nanGuard(func() Decimal {
+		if x.IsInf(0) && y.IsInf(0) && x.Sign() == y.Sign() {
+			return nanDecimal(ErrIndeterminate, "Sub", x, y)
+		}
+
+		return Decimal{fl: c.newFloat(maxPrecision(x, y)+1).Sub(x.value(), y.value())}
+	}, x, y)
+}
+
+// Mul returns the product of x and y.
+func (c Context) Mul(x, y Decimal) Decimal {
+	return nanGuard(func() Decimal {
+		if (x.IsInf(0) && y.IsZero()) || (x.IsZero() && y.IsInf(0)) {
+			return nanDecimal(ErrIndeterminate, "Mul", x, y)
+		}
+
+		return Decimal{fl: c.newFloat(sumPrecision(x, y)).Mul(x.value(), y.value())}
+	}, x, y)
+}
+
+// Div returns the quotient of x and y.
+func (c Context) Div(x, y Decimal) Decimal {
+	return nanGuard(func() Decimal {
+		if x.IsZero() && y.IsZero() {
+			return nanDecimal(ErrDivisionByZero, "Div", x, y)
+		}
+
+		if x.IsInf(0) && y.IsInf(0) {
+			return nanDecimal(ErrIndeterminate, "Div", x, y)
+		}
+
+		return Decimal{fl: c.newFloat(maxPrecision(x, y)).Quo(x.value(), y.value())}
+	}, x, y)
+}
+
+// Pow returns x to the inputted power
+func (c Context) Pow(x Decimal, exp int) Decimal {
+	return nanGuard(func() Decimal {
+		if exp == 0 {
+			return oneDecimal()
+		}
+
+		if x.EQ(oneDecimal()) {
+			return oneDecimal()
+		}
+
+		if exp < 0 {
+			return c.Div(oneDecimal(), c.pow(x, negativeExponentMagnitude(exp)))
+		}
+
+		return c.pow(x, uint(exp))
+	}, x)
+}
+
+func (c Context) pow(x Decimal, exp uint) Decimal {
+	result := oneDecimal()
+	base := Decimal{fl: x.cpy()}
+
+	for exp > 0 {
+		if exp%2 == 1 {
+			result = c.Mul(result, base)
+		}
+
+		exp /= 2
+		if exp > 0 {
+			base = c.Mul(base, base)
+		}
+	}
+
+	return result
+}
+
+// Sqrt returns the square root of x
+func (c Context) Sqrt(x Decimal) Decimal {
+	return nanGuard(func() Decimal {
+		if x.LT(zeroDecimal()) {
+			return nanDecimal(ErrDomain, "Sqrt", x)
+		}
+
+		return Decimal{fl: c.newFloat(x.value().Prec()).Sqrt(x.value())}
+	}, x)
+}
+
+// newFloat returns a float sized for a result whose natural precision, absent any
+// Context settings, would be the one given.
+func (c Context) newFloat(precision uint) *big.Float {
+	if c.Precision > 0 {
+		precision = c.Precision
+	} else if precision < minPrecision {
+		precision = minPrecision
+	}
+
+	if c.MaxPrecision > 0 && precision > c.MaxPrecision {
+		precision = c.MaxPrecision
+	}
+
+	return new(big.Float).SetPrec(precision).SetMode(c.Mode)
+}
+
+func digitsPrecision(digits uint) uint {
+	return uint(math.Ceil(float64(digits) * math.Log2(10)))
+}
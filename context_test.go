@@ -0,0 +1,86 @@
+package big
+
+import (
+	mathbig "math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewContextDigits(t *testing.T) {
+	assert.EqualValues(t, 113, NewContextDigits(34, mathbig.ToNearestEven).Precision)
+	assert.EqualValues(t, 54, NewContextDigits(16, mathbig.ToNearestEven).Precision)
+}
+
+func TestContext_Precision(t *testing.T) {
+	ctx := NewContextDigits(34, mathbig.ToNearestEven)
+	third := ctx.Div(ONE, NewFromInt(3))
+
+	assert.EqualValues(t, 113, third.fl.Prec())
+	assert.EqualValues(t, "0.3333333333333333333333333333333333", third.value().Text('g', 34))
+
+	product := ctx.Mul(third, third)
+	assert.EqualValues(t, 113, product.fl.Prec())
+}
+
+func TestContext_MaxPrecision(t *testing.T) {
+	ctx := DefaultContext.WithMaxPrecision(300)
+	long := NewFromString("9999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999999")
+
+	result := long
+	for i := 0; i < 5; i++ {
+		result = ctx.Mul(result, long)
+	}
+
+	assert.EqualValues(t, 300, result.fl.Prec())
+	assert.EqualValues(t, 832, DefaultContext.Mul(long, long).fl.Prec())
+}
+
+func TestContext_Mode(t *testing.T) {
+	down := NewContext(8, mathbig.ToZero).Div(NewFromInt(2), NewFromInt(3))
+	up := NewContext(8, mathbig.AwayFromZero).Div(NewFromInt(2), NewFromInt(3))
+
+	assert.True(t, down.LT(up))
+	assert.EqualValues(t, "0.66406250", down.value().Text('f', 8))
+	assert.EqualValues(t, "0.66796875", up.value().Text('f', 8))
+}
+
+func TestContext_Pow(t *testing.T) {
+	ctx := NewContext(64, mathbig.ToNearestEven)
+
+	validateEqExamples(t,
+		equalExample{
+			value:    ctx.Pow(NewFromInt(3), 4),
+			expected: "81",
+		},
+		equalExample{
+			value:    ctx.Pow(TEN, -3),
+			expected: "0.001",
+		},
+		equalExample{
+			value:    ctx.Pow(NaN, 2),
+			expected: "NaN",
+		},
+	)
+
+	assert.EqualValues(t, 64, ctx.Pow(NewFromString("1.0001"), 1000).fl.Prec())
+}
+
+func TestContext_Sqrt(t *testing.T) {
+	ctx := NewContext(64, mathbig.ToNearestEven)
+
+	assert.EqualValues(t, 64, ctx.Sqrt(NewFromInt(2)).fl.Prec())
+	assert.EqualValues(t, "NaN", ctx.Sqrt(NewFromInt(-2)).String())
+}
+
+func TestDefaultContext(t *testing.T) {
+	oldContext := DefaultContext
+	t.Cleanup(func() {
+		DefaultContext = oldContext
+	})
+
+	DefaultContext = NewContext(64, mathbig.ToNearestEven)
+
+	assert.EqualValues(t, 64, ONE.Div(NewFromInt(3)).fl.Prec())
+	assert.EqualValues(t, 64, TEN.Add(ONE).fl.Prec())
+}
@@ -0,0 +1,128 @@
+package big
+
+import (
+	"math"
+	"math/big"
+	"math/bits"
+)
+
+// NewFromInt64 creates a new Decimal type from an int64 value
+func NewFromInt64(dec int64) Decimal {
+	fl := newFloat(intPrecision(dec))
+	fl.SetInt64(dec)
+	return Decimal{fl: fl}
+}
+
+// NewFromUint64 creates a new Decimal type from a uint64 value
+func NewFromUint64(dec uint64) Decimal {
+	fl := newFloat(uint(bits.Len64(dec)))
+	fl.SetUint64(dec)
+	return Decimal{fl: fl}
+}
+
+// NewFromFloat32 creates a new Decimal type from a float32 value. The conversion is exact.
+func NewFromFloat32(val float32) Decimal {
+	if math.IsNaN(float64(val)) {
+		return Decimal{nan: true}
+	}
+
+	fl := newFloat(24)
+	fl.SetFloat64(float64(val))
+
+	return Decimal{fl: fl}
+}
+
+// NewFromBigInt creates a new Decimal type from a *big.Int value. The conversion is
+// exact, and a nil value is NaN.
+func NewFromBigInt(val *big.Int) Decimal {
+	if val == nil {
+		return Decimal{nan: true}
+	}
+
+	fl := newFloat(uint(val.BitLen()))
+	fl.SetInt(val)
+
+	return Decimal{fl: fl}
+}
+
+// NewFromRat creates a new Decimal type from a *big.Rat value, along with the accuracy of
+// the conversion. Rationals whose denominator is a power of two convert exactly; others
+// are rounded to the nearest value at the default precision. A nil value is NaN.
+func NewFromRat(val *big.Rat) (Decimal, big.Accuracy) {
+	if val == nil {
+		return Decimal{nan: true}, big.Exact
+	}
+
+	fl := newFloat(uint(val.Num().BitLen()))
+	fl.SetRat(val)
+
+	return Decimal{fl: fl}, fl.Acc()
+}
+
+// BigInt returns this Decimal truncated to an integer, along with the accuracy of the
+// result. It returns nil for NaN and infinities.
+func (d Decimal) BigInt() (*big.Int, big.Accuracy) {
+	if d.NaN() {
+		return nil, big.Exact
+	}
+
+	return d.value().Int(nil)
+}
+
+// Rat returns the exact value of this Decimal as a *big.Rat. It returns nil for NaN and
+// infinities, with an accuracy of Below for +Inf and Above for -Inf.
+func (d Decimal) Rat() (*big.Rat, big.Accuracy) {
+	if d.NaN() {
+		return nil, big.Exact
+	}
+
+	return d.value().Rat(nil)
+}
+
+// Int64 returns this Decimal truncated to an int64, and whether the result fits. ok is
+// false for NaN, infinities and values outside the range of an int64.
+func (d Decimal) Int64() (val int64, ok bool) {
+	integer, _ := d.BigInt()
+	if integer == nil || !integer.IsInt64() {
+		return 0, false
+	}
+
+	return integer.Int64(), true
+}
+
+// Uint64 returns this Decimal truncated to a uint64, and whether the result fits. ok is
+// false for NaN, infinities and values outside the range of a uint64.
+func (d Decimal) Uint64() (val uint64, ok bool) {
+	integer, _ := d.BigInt()
+	if integer == nil || !integer.IsUint64() {
+		return 0, false
+	}
+
+	return integer.Uint64(), true
+}
+
+// IntPart returns the integer part of this Decimal, truncated toward zero. Infinities
+// are their own integer parts.
+func (d Decimal) IntPart() Decimal {
+	if !d.finite() {
+		return d
+	}
+
+	intPart, _ := d.QuoRem(oneDecimal())
+	return intPart
+}
+
+// FracPart returns the fractional part of this Decimal, d - d.IntPart(), which has the
+// sign of this Decimal. Like Round, it operates on the shortest decimal representation
+// of this Decimal, so NewFromString("2.3").FracPart() equals NewFromString("0.3").
+// Infinities have no fractional part and return NaN.
+func (d Decimal) FracPart() Decimal {
+	if d.IsInf(0) {
+		return nanDecimal(ErrDomain, "FracPart", d)
+	} else if !d.NaN() && d.magnitudeBelow(0) {
+		return d
+	}
+
+	_, fracPart := d.QuoRem(oneDecimal())
+	return fracPart
+}
@@ -0,0 +1,162 @@
+package big
+
+import (
+	"errors"
+	"math"
+	mathbig "math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewFromInt64(t *testing.T) {
+	assert.EqualValues(t, "-9223372036854775808", NewFromInt64(math.MinInt64).FormattedString(0))
+	assert.EqualValues(t, "9223372036854775807", NewFromInt64(math.MaxInt64).FormattedString(0))
+	assert.True(t, NewFromInt64(42).EQ(NewFromInt(42)))
+}
+
+func TestNewFromUint64(t *testing.T) {
+	assert.EqualValues(t, "18446744073709551615", NewFromUint64(math.MaxUint64).FormattedString(0))
+	assert.True(t, NewFromUint64(0).IsZero())
+}
+
+func TestNewFromFloat32(t *testing.T) {
+	assert.EqualValues(t, "0.100000001490116119384765625", NewFromFloat32(0.1).FormattedString(27))
+	assert.True(t, NewFromFloat32(float32(math.Inf(-1))).IsInf(-1))
+	assert.True(t, NewFromFloat32(float32(math.NaN())).NaN())
+}
+
+func TestNewFromBigInt(t *testing.T) {
+	huge, _ := new(mathbig.Int).SetString("123456789012345678901234567890123456789012345678901234567890123456789012345678901234567890", 10)
+
+	d := NewFromBigInt(huge)
+	assert.EqualValues(t, huge.String(), d.FormattedString(0))
+
+	integer, accuracy := d.BigInt()
+	assert.EqualValues(t, mathbig.Exact, accuracy)
+	assert.Zero(t, huge.Cmp(integer))
+
+	assert.True(t, NewFromBigInt(nil).NaN())
+}
+
+func TestNewFromRat(t *testing.T) {
+	d, accuracy := NewFromRat(mathbig.NewRat(-5, 4))
+	assert.EqualValues(t, mathbig.Exact, accuracy)
+	assert.True(t, d.EQ(NewFromString("-1.25")))
+
+	d, accuracy = NewFromRat(mathbig.NewRat(1, 3))
+	assert.EqualValues(t, mathbig.Above, accuracy)
+	assertDigits(t, "0.3333333333333333333333333333333333333333333333333333333333333333333333333", d, 70)
+
+	d, _ = NewFromRat(nil)
+	assert.True(t, d.NaN())
+}
+
+func TestDecimal_BigInt(t *testing.T) {
+	examples := []struct {
+		value    Decimal
+		expected string
+		accuracy mathbig.Accuracy
+	}{
+		{NewFromString("12.9"), "12", mathbig.Below},
+		{NewFromString("-12.9"), "-12", mathbig.Above},
+		{NewFromString("1e40"), "10000000000000000000000000000000000000000", mathbig.Exact},
+		{ZERO, "0", mathbig.Exact},
+	}
+
+	for _, ex := range examples {
+		integer, accuracy := ex.value.BigInt()
+
+		assert.EqualValues(t, ex.expected, integer.String())
+		assert.EqualValues(t, ex.accuracy, accuracy)
+	}
+
+	for _, d := range []Decimal{NaN, PosInf(), NegInf()} {
+		integer, _ := d.BigInt()
+		assert.Nil(t, integer, d.String())
+	}
+}
+
+func TestDecimal_Rat(t *testing.T) {
+	r, accuracy := NewFromString("-2.5").Rat()
+	assert.EqualValues(t, mathbig.Exact, accuracy)
+	assert.EqualValues(t, "-5/2", r.String())
+
+	r, _ = NewDecimal(0.1).Rat()
+	assert.EqualValues(t, "3602879701896397/36028797018963968", r.String())
+
+	for _, d := range []Decimal{NaN, PosInf(), NegInf()} {
+		r, _ := d.Rat()
+		assert.Nil(t, r, d.String())
+	}
+}
+
+func TestDecimal_Int64(t *testing.T) {
+	val, ok := NewFromString("-99.99").Int64()
+	assert.True(t, ok)
+	assert.EqualValues(t, -99, val)
+
+	val, ok = NewFromInt64(math.MinInt64).Int64()
+	assert.True(t, ok)
+	assert.EqualValues(t, math.MinInt64, val)
+
+	for _, d := range []Decimal{NewFromUint64(math.MaxInt64 + 1), NaN, PosInf()} {
+		_, ok := d.Int64()
+		assert.False(t, ok, d.String())
+	}
+}
+
+func TestDecimal_Uint64(t *testing.T) {
+	val, ok := NewFromUint64(math.MaxUint64).Uint64()
+	assert.True(t, ok)
+	assert.EqualValues(t, uint64(math.MaxUint64), val)
+
+	val, ok = NewFromString("-0.5").Uint64()
+	assert.True(t, ok)
+	assert.EqualValues(t, 0, val)
+
+	for _, d := range []Decimal{NewFromInt(-1), NewFromString("1e20"), NaN, NegInf()} {
+		_, ok := d.Uint64()
+		assert.False(t, ok, d.String())
+	}
+}
+
+func TestDecimal_IntPart(t *testing.T) {
+	validateEqExamples(t,
+		equalExample{
+			value:    NewFromString("2.3").IntPart(),
+			expected: "2",
+		},
+		equalExample{
+			value:    NewFromString("-2.7").IntPart(),
+			expected: "-2",
+		},
+		equalExample{
+			value:    NegInf().IntPart(),
+			expected: "-Inf",
+		},
+		equalExample{
+			value:    NaN.IntPart(),
+			expected: "NaN",
+		},
+	)
+}
+
+func TestDecimal_FracPart(t *testing.T) {
+	validateEqExamples(t,
+		equalExample{
+			value:    NewFromString("2.3").FracPart(),
+			expected: "0.3",
+		},
+		equalExample{
+			value:    NewFromString("-2.75").FracPart(),
+			expected: "-0.75",
+		},
+		equalExample{
+			value:    NewFromInt(5).FracPart(),
+			expected: "0",
+		},
+	)
+
+	assert.True(t, errors.Is(PosInf().FracPart().NaNReason(), ErrDomain))
+}
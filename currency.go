@@ -0,0 +1,202 @@
+package big
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrUnknownCurrency indicates that a currency code is not in the ISO 4217 table.
+var ErrUnknownCurrency = errors.New("unknown currency")
+
+// Currency is an ISO 4217 currency.
+type Currency struct {
+	Code       string // the three-letter code, e.g. "USD"
+	MinorUnits int    // the number of digits after the decimal point in amounts
+	Name       string // the English name of the currency
+}
+
+// LookupCurrency returns the ISO 4217 currency with the given code, in any case. It
+// returns an error matching ErrUnknownCurrency if there is no such currency.
+func LookupCurrency(code string) (Currency, error) {
+	currency, ok := currencies[strings.ToUpper(code)]
+	if !ok {
+		return Currency{}, fmt.Errorf("big: %w %q", ErrUnknownCurrency, code)
+	}
+
+	return currency, nil
+}
+
+// String returns the currency's code.
+func (c Currency) String() string {
+	return c.Code
+}
+
+// currencies are the active ISO 4217 currencies, by code.
+var currencies = map[string]Currency{
+	"AED": {Code: "AED", MinorUnits: 2, Name: "United Arab Emirates dirham"},
+	"AFN": {Code: "AFN", MinorUnits: 2, Name: "Afghan afghani"},
+	"ALL": {Code: "ALL", MinorUnits: 2, Name: "Albanian lek"},
+	"AMD": {Code: "AMD", MinorUnits: 2, Name: "Armenian dram"},
+	"ANG": {Code: "ANG", MinorUnits: 2, Name: "Netherlands Antillean guilder"},
+	"AOA": {Code: "AOA", MinorUnits: 2, Name: "Angolan kwanza"},
+	"ARS": {Code: "ARS", MinorUnits: 2, Name: "Argentine peso"},
+	"AUD": {Code: "AUD", MinorUnits: 2, Name: "Australian dollar"},
+	"AWG": {Code: "AWG", MinorUnits: 2, Name: "Aruban florin"},
+	"AZN": {Code: "AZN", MinorUnits: 2, Name: "Azerbaijani manat"},
+	"BAM": {Code: "BAM", MinorUnits: 2, Name: "Bosnia and Herzegovina convertible mark"},
+	"BBD": {Code: "BBD", MinorUnits: 2, Name: "Barbados dollar"},
+	"BDT": {Code: "BDT", MinorUnits: 2, Name: "Bangladeshi taka"},
+	"BGN": {Code: "BGN", MinorUnits: 2, Name: "Bulgarian lev"},
+	"BHD": {Code: "BHD", MinorUnits: 3, Name: "Bahraini dinar"},
+	"BIF": {Code: "BIF", MinorUnits: 0, Name: "Burundian franc"},
+	"BMD": {Code: "BMD", MinorUnits: 2, Name: "Bermudian dollar"},
+	"BND": {Code: "BND", MinorUnits: 2, Name: "Brunei dollar"},
+	"BOB": {Code: "BOB", MinorUnits: 2, Name: "Boliviano"},
+	"BOV": {Code: "BOV", MinorUnits: 2, Name: "Bolivian Mvdol"},
+	"BRL": {Code: "BRL", MinorUnits: 2, Name: "Brazilian real"},
+	"BSD": {Code: "BSD", MinorUnits: 2, Name: "Bahamian dollar"},
+	"BTN": {Code: "BTN", MinorUnits: 2, Name: "Bhutanese ngultrum"},
+	"BWP": {Code: "BWP", MinorUnits: 2, Name: "Botswana pula"},
+	"BYN": {Code: "BYN", MinorUnits: 2, Name: "Belarusian ruble"},
+	"BZD": {Code: "BZD", MinorUnits: 2, Name: "Belize dollar"},
+	"CAD": {Code: "CAD", MinorUnits: 2, Name: "Canadian dollar"},
+	"CDF": {Code: "CDF", MinorUnits: 2, Name: "Congolese franc"},
+	"CHE": {Code: "CHE", MinorUnits: 2, Name: "WIR euro"},
+	"CHF": {Code: "CHF", MinorUnits: 2, Name: "Swiss franc"},
+	"CHW": {Code: "CHW", MinorUnits: 2, Name: "WIR franc"},
+	"CLF": {Code: "CLF", MinorUnits: 4, Name: "Unidad de Fomento"},
+	"CLP": {Code: "CLP", MinorUnits: 0, Name: "Chilean peso"},
+	"CNY": {Code: "CNY", MinorUnits: 2, Name: "Renminbi"},
+	"COP": {Code: "COP", MinorUnits: 2, Name: "Colombian peso"},
+	"COU": {Code: "COU", MinorUnits: 2, Name: "Unidad de Valor Real"},
+	"CRC": {Code: "CRC", MinorUnits: 2, Name: "Costa Rican colon"},
+	"CUP": {Code: "CUP", MinorUnits: 2, Name: "Cuban peso"},
+	"CVE": {Code: "CVE", MinorUnits: 2, Name: "Cape Verdean escudo"},
+	"CZK": {Code: "CZK", MinorUnits: 2, Name: "Czech koruna"},
+	"DJF": {Code: "DJF", MinorUnits: 0, Name: "Djiboutian franc"},
+	"DKK": {Code: "DKK", MinorUnits: 2, Name: "Danish krone"},
+	"DOP": {Code: "DOP", MinorUnits: 2, Name: "Dominican peso"},
+	"DZD": {Code: "DZD", MinorUnits: 2, Name: "Algerian dinar"},
+	"EGP": {Code: "EGP", MinorUnits: 2, Name: "Egyptian pound"},
+	"ERN": {Code: "ERN", MinorUnits: 2, Name: "Eritrean nakfa"},
+	"ETB": {Code: "ETB", MinorUnits: 2, Name: "Ethiopian birr"},
+	"EUR": {Code: "EUR", MinorUnits: 2, Name: "Euro"},
+	"FJD": {Code: "FJD", MinorUnits: 2, Name: "Fiji dollar"},
+	"FKP": {Code: "FKP", MinorUnits: 2, Name: "Falkland Islands pound"},
+	"GBP": {Code: "GBP", MinorUnits: 2, Name: "Pound sterling"},
+	"GEL": {Code: "GEL", MinorUnits: 2, Name: "Georgian lari"},
+	"GHS": {Code: "GHS", MinorUnits: 2, Name: "Ghanaian cedi"},
+	"GIP": {Code: "GIP", MinorUnits: 2, Name: "Gibraltar pound"},
+	"GMD": {Code: "GMD", MinorUnits: 2, Name: "Gambian dalasi"},
+	"GNF": {Code: "GNF", MinorUnits: 0, Name: "Guinean franc"},
+	"GTQ": {Code: "GTQ", MinorUnits: 2, Name: "Guatemalan quetzal"},
+	"GYD": {Code: "GYD", MinorUnits: 2, Name: "Guyanese dollar"},
+	"HKD": {Code: "HKD", MinorUnits: 2, Name: "Hong Kong dollar"},
+	"HNL": {Code: "HNL", MinorUnits: 2, Name: "Honduran lempira"},
+	"HTG": {Code: "HTG", MinorUnits: 2, Name: "Haitian gourde"},
+	"HUF": {Code: "HUF", MinorUnits: 2, Name: "Hungarian forint"},
+	"IDR": {Code: "IDR", MinorUnits: 2, Name: "Indonesian rupiah"},
+	"ILS": {Code: "ILS", MinorUnits: 2, Name: "Israeli new shekel"},
+	"INR": {Code: "INR", MinorUnits: 2, Name: "Indian rupee"},
+	"IQD": {Code: "IQD", MinorUnits: 3, Name: "Iraqi dinar"},
+	"IRR": {Code: "IRR", MinorUnits: 2, Name: "Iranian rial"},
+	"ISK": {Code: "ISK", MinorUnits: 0, Name: "Icelandic krona"},
+	"JMD": {Code: "JMD", MinorUnits: 2, Name: "Jamaican dollar"},
+	"JOD": {Code: "JOD", MinorUnits: 3, Name: "Jordanian dinar"},
+	"JPY": {Code: "JPY", MinorUnits: 0, Name: "Japanese yen"},
+	"KES": {Code: "KES", MinorUnits: 2, Name: "Kenyan shilling"},
+	"KGS": {Code: "KGS", MinorUnits: 2, Name: "Kyrgyzstani som"},
+	"KHR": {Code: "KHR", MinorUnits: 2, Name: "Cambodian riel"},
+	"KMF": {Code: "KMF", MinorUnits: 0, Name: "Comoro franc"},
+	"KPW": {Code: "KPW", MinorUnits: 2, Name: "North Korean won"},
+	"KRW": {Code: "KRW", MinorUnits: 0, Name: "South Korean won"},
+	"KWD": {Code: "KWD", MinorUnits: 3, Name: "Kuwaiti dinar"},
+	"KYD": {Code: "KYD", MinorUnits: 2, Name: "Cayman Islands dollar"},
+	"KZT": {Code: "KZT", MinorUnits: 2, Name: "Kazakhstani tenge"},
+	"LAK": {Code: "LAK", MinorUnits: 2, Name: "Lao kip"},
+	"LBP": {Code: "LBP", MinorUnits: 2, Name: "Lebanese pound"},
+	"LKR": {Code: "LKR", MinorUnits: 2, Name: "Sri Lankan rupee"},
+	"LRD": {Code: "LRD", MinorUnits: 2, Name: "Liberian dollar"},
+	"LSL": {Code: "LSL", MinorUnits: 2, Name: "Lesotho loti"},
+	"LYD": {Code: "LYD", MinorUnits: 3, Name: "Libyan dinar"},
+	"MAD": {Code: "MAD", MinorUnits: 2, Name: "Moroccan dirham"},
+	"MDL": {Code: "MDL", MinorUnits: 2, Name: "Moldovan leu"},
+	"MGA": {Code: "MGA", MinorUnits: 2, Name: "Malagasy ariary"},
+	"MKD": {Code: "MKD", MinorUnits: 2, Name: "Macedonian denar"},
+	"MMK": {Code: "MMK", MinorUnits: 2, Name: "Myanmar kyat"},
+	"MNT": {Code: "MNT", MinorUnits: 2, Name: "Mongolian togrog"},
+	"MOP": {Code: "MOP", MinorUnits: 2, Name: "Macanese pataca"},
+	"MRU": {Code: "MRU", MinorUnits: 2, Name: "Mauritanian ouguiya"},
+	"MUR": {Code: "MUR", MinorUnits: 2, Name: "Mauritian rupee"},
+	"MVR": {Code: "MVR", MinorUnits: 2, Name: "Maldivian rufiyaa"},
+	"MWK": {Code: "MWK", MinorUnits: 2, Name: "Malawian kwacha"},
+	"MXN": {Code: "MXN", MinorUnits: 2, Name: "Mexican peso"},
+	"MXV": {Code: "MXV", MinorUnits: 2, Name: "Mexican Unidad de Inversion"},
+	"MYR": {Code: "MYR", MinorUnits: 2, Name: "Malaysian ringgit"},
+	"MZN": {Code: "MZN", MinorUnits: 2, Name: "Mozambican metical"},
+	"NAD": {Code: "NAD", MinorUnits: 2, Name: "Namibian dollar"},
+	"NGN": {Code: "NGN", MinorUnits: 2, Name: "Nigerian naira"},
+	"NIO": {Code: "NIO", MinorUnits: 2, Name: "Nicaraguan cordoba"},
+	"NOK": {Code: "NOK", MinorUnits: 2, Name: "Norwegian krone"},
+	"NPR": {Code: "NPR", MinorUnits: 2, Name: "Nepalese rupee"},
+	"NZD": {Code: "NZD", MinorUnits: 2, Name: "New Zealand dollar"},
+	"OMR": {Code: "OMR", MinorUnits: 3, Name: "Omani rial"},
+	"PAB": {Code: "PAB", MinorUnits: 2, Name: "Panamanian balboa"},
+	"PEN": {Code: "PEN", MinorUnits: 2, Name: "Peruvian sol"},
+	"PGK": {Code: "PGK", MinorUnits: 2, Name: "Papua New Guinean kina"},
+	"PHP": {Code: "PHP", MinorUnits: 2, Name: "Philippine peso"},
+	"PKR": {Code: "PKR", MinorUnits: 2, Name: "Pakistani rupee"},
+	"PLN": {Code: "PLN", MinorUnits: 2, Name: "Polish zloty"},
+	"PYG": {Code: "PYG", MinorUnits: 0, Name: "Paraguayan guarani"},
+	"QAR": {Code: "QAR", MinorUnits: 2, Name: "Qatari riyal"},
+	"RON": {Code: "RON", MinorUnits: 2, Name: "Romanian leu"},
+	"RSD": {Code: "RSD", MinorUnits: 2, Name: "Serbian dinar"},
+	"RUB": {Code: "RUB", MinorUnits: 2, Name: "Russian ruble"},
+	"RWF": {Code: "RWF", MinorUnits: 0, Name: "Rwandan franc"},
+	"SAR": {Code: "SAR", MinorUnits: 2, Name: "Saudi riyal"},
+	"SBD": {Code: "SBD", MinorUnits: 2, Name: "Solomon Islands dollar"},
+	"SCR": {Code: "SCR", MinorUnits: 2, Name: "Seychelles rupee"},
+	"SDG": {Code: "SDG", MinorUnits: 2, Name: "Sudanese pound"},
+	"SEK": {Code: "SEK", MinorUnits: 2, Name: "Swedish krona"},
+	"SGD": {Code: "SGD", MinorUnits: 2, Name: "Singapore dollar"},
+	"SHP": {Code: "SHP", MinorUnits: 2, Name: "Saint Helena pound"},
+	"SLE": {Code: "SLE", MinorUnits: 2, Name: "Sierra Leonean leone"},
+	"SOS": {Code: "SOS", MinorUnits: 2, Name: "Somali shilling"},
+	"SRD": {Code: "SRD", MinorUnits: 2, Name: "Surinamese dollar"},
+	"SSP": {Code: "SSP", MinorUnits: 2, Name: "South Sudanese pound"},
+	"STN": {Code: "STN", MinorUnits: 2, Name: "Sao Tome and Principe dobra"},
+	"SVC": {Code: "SVC", MinorUnits: 2, Name: "Salvadoran colon"},
+	"SYP": {Code: "SYP", MinorUnits: 2, Name: "Syrian pound"},
+	"SZL": {Code: "SZL", MinorUnits: 2, Name: "Swazi lilangeni"},
+	"THB": {Code: "THB", MinorUnits: 2, Name: "Thai baht"},
+	"TJS": {Code: "TJS", MinorUnits: 2, Name: "Tajikistani somoni"},
+	"TMT": {Code: "TMT", MinorUnits: 2, Name: "Turkmenistan manat"},
+	"TND": {Code: "TND", MinorUnits: 3, Name: "Tunisian dinar"},
+	"TOP": {Code: "TOP", MinorUnits: 2, Name: "Tongan pa'anga"},
+	"TRY": {Code: "TRY", MinorUnits: 2, Name: "Turkish lira"},
+	"TTD": {Code: "TTD", MinorUnits: 2, Name: "Trinidad and Tobago dollar"},
+	"TWD": {Code: "TWD", MinorUnits: 2, Name: "New Taiwan dollar"},
+	"TZS": {Code: "TZS", MinorUnits: 2, Name: "Tanzanian shilling"},
+	"UAH": {Code: "UAH", MinorUnits: 2, Name: "Ukrainian hryvnia"},
+	"UGX": {Code: "UGX", MinorUnits: 0, Name: "Ugandan shilling"},
+	"USD": {Code: "USD", MinorUnits: 2, Name: "United States dollar"},
+	"USN": {Code: "USN", MinorUnits: 2, Name: "United States dollar (next day)"},
+	"UYI": {Code: "UYI", MinorUnits: 0, Name: "Uruguay Peso en Unidades Indexadas"},
+	"UYU": {Code: "UYU", MinorUnits: 2, Name: "Uruguayan peso"},
+	"UYW": {Code: "UYW", MinorUnits: 4, Name: "Unidad previsional"},
+	"UZS": {Code: "UZS", MinorUnits: 2, Name: "Uzbekistani sum"},
+	"VED": {Code: "VED", MinorUnits: 2, Name: "Venezuelan digital bolivar"},
+	"VES": {Code: "VES", MinorUnits: 2, Name: "Venezuelan sovereign bolivar"},
+	"VND": {Code: "VND", MinorUnits: 0, Name: "Vietnamese dong"},
+	"VUV": {Code: "VUV", MinorUnits: 0, Name: "Vanuatu vatu"},
+	"WST": {Code: "WST", MinorUnits: 2, Name: "Samoan tala"},
+	"XAF": {Code: "XAF", MinorUnits: 0, Name: "CFA franc BEAC"},
+	"XCD": {Code: "XCD", MinorUnits: 2, Name: "East Caribbean dollar"},
+	"XOF": {Code: "XOF", MinorUnits: 0, Name: "CFA franc BCEAO"},
+	"XPF": {Code: "XPF", MinorUnits: 0, Name: "CFP franc"},
+	"YER": {Code: "YER", MinorUnits: 2, Name: "Yemeni rial"},
+	"ZAR": {Code: "ZAR", MinorUnits: 2, Name: "South African rand"},
+	"ZMW": {Code: "ZMW", MinorUnits: 2, Name: "Zambian kwacha"},
+	"ZWG": {Code: "ZWG", MinorUnits: 2, Name: "Zimbabwe Gold"},
+}
@@ -0,0 +1,42 @@
+package big
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLookupCurrency(t *testing.T) {
+	examples := map[string]int{
+		"USD": 2,
+		"eur": 2,
+		"JPY": 0,
+		"KWD": 3,
+		"CLF": 4,
+	}
+
+	for code, minorUnits := range examples {
+		currency, err := LookupCurrency(code)
+
+		if assert.NoError(t, err, code) {
+			assert.EqualValues(t, minorUnits, currency.MinorUnits, code)
+		}
+	}
+
+	usd, _ := LookupCurrency("usd")
+	assert.EqualValues(t, "USD", usd.String())
+	assert.EqualValues(t, "United States dollar", usd.Name)
+
+	_, err := LookupCurrency("XYZ")
+	assert.True(t, errors.Is(err, ErrUnknownCurrency))
+	assert.EqualError(t, err, `big: unknown currency "XYZ"`)
+}
+
+func TestCurrencies(t *testing.T) {
+	for code, currency := range currencies {
+		assert.EqualValues(t, code, currency.Code)
+		assert.Len(t, code, 3)
+		assert.NotEmpty(t, currency.Name, code)
+	}
+}
@@ -0,0 +1,6 @@
+//go:build bigdebug
+
+package big
+
+// debugNaN records the operation and operands that produce each NaN.
+const debugNaN = true
@@ -8,6 +8,8 @@ import (
 	"math"
 	"math/big"
 	"math/bits"
+	"strconv"
+	"strings"
 	"unicode"
 )
 
@@ -34,8 +36,9 @@ var (
 
 // Decimal is the main exported type. It is a simple, immutable wrapper around a *big.Float
 type Decimal struct {
-	fl  *big.Float
-	nan bool
+	fl     *big.Float
+	nan    bool
+	reason *NaNError
 }
 
 // NewDecimal creates a new Decimal type from a float value.
@@ -52,22 +55,16 @@ func NewDecimal(val float64) Decimal {
 	}
 }
 
-// NewFromString creates a new Decimal type from a string value.
+// NewFromString creates a new Decimal type from a string value. It returns NaN if the
+// string cannot be parsed; use Parse to find out why.
 func NewFromString(str string) Decimal {
-	bfl := newFloat(decimalPrecision(str))
-
-	if _, _, err := bfl.Parse(str, 10); err != nil {
-		return NaN
-	}
-
-	return Decimal{fl: bfl}
+	d, _ := Parse(str)
+	return d
 }
 
 // NewFromInt creates a new Decimal type from an int value
 func NewFromInt(dec int) Decimal {
-	fl := newFloat(intPrecision(dec))
-	fl.SetInt64(int64(dec))
-	return Decimal{fl: fl}
+	return NewFromInt64(int64(dec))
 }
 
 func newFloat(precision uint) *big.Float {
@@ -101,7 +98,7 @@ func decimalPrecision(str string) uint {
 	return uint(digits)*4 + 16
 }
 
-func intPrecision(dec int) uint {
+func intPrecision(dec int64) uint {
 	if dec == 0 {
 		return minPrecision
 	}
@@ -116,13 +113,13 @@ func intPrecision(dec int) uint {
 
 // MaxSlice returns the max of a slice of decimals
 func MaxSlice(decimals ...Decimal) Decimal {
-	if anyNan(decimals...) {
-		return NaN
+	if nan, ok := firstNaN(decimals...); ok {
+		return nan
 	} else if len(decimals) == 0 {
 		return zeroDecimal()
 	}
 
-	initial := NewFromString("-Inf")
+	initial := NegInf()
 
 	for _, decimal := range decimals {
 		if decimal.GT(initial) {
@@ -135,13 +132,13 @@ func MaxSlice(decimals ...Decimal) Decimal {
 
 // MinSlice returns the min of a slice of decimals
 func MinSlice(decimals ...Decimal) Decimal {
-	if anyNan(decimals...) {
-		return NaN
+	if nan, ok := firstNaN(decimals...); ok {
+		return nan
 	} else if len(decimals) == 0 {
 		return zeroDecimal()
 	}
 
-	initial := NewFromString("Inf")
+	initial := PosInf()
 	for _, decimal := range decimals {
 		if decimal.LT(initial) {
 			initial = decimal
@@ -153,30 +150,22 @@ func MinSlice(decimals ...Decimal) Decimal {
 
 // Add adds a decimal instance to another Decimal instance.
 func (d Decimal) Add(addend Decimal) Decimal {
-	return nanGuard(func() Decimal {
-		return Decimal{fl: resultFloat(1, d, addend).Add(d.value(), addend.value())}
-	}, d, addend)
+	return DefaultContext.Add(d, addend)
 }
 
 // Sub subtracts another decimal instance from this Decimal instance.
 func (d Decimal) Sub(subtrahend Decimal) Decimal {
-	return nanGuard(func() Decimal {
-		return Decimal{fl: resultFloat(1, d, subtrahend).Sub(d.value(), subtrahend.value())}
-	}, d, subtrahend)
+	return DefaultContext.Sub(d, subtrahend)
 }
 
 // Mul multiplies another decimal instance with this Decimal instance.
 func (d Decimal) Mul(factor Decimal) Decimal {
-	return nanGuard(func() Decimal {
-		return Decimal{fl: newFloat(sumPrecision(d, factor)).Mul(d.value(), factor.value())}
-	}, d, factor)
+	return DefaultContext.Mul(d, factor)
 }
 
 // Div divides this Decimal by the denominator passed.
 func (d Decimal) Div(denominator Decimal) Decimal {
-	return nanGuard(func() Decimal {
-		return Decimal{fl: resultFloat(0, d, denominator).Quo(d.value(), denominator.value())}
-	}, d, denominator)
+	return DefaultContext.Div(d, denominator)
 }
 
 // Frac returns another Decimal instance representing this Decimal multiplied by the
@@ -207,45 +196,7 @@ func (d Decimal) Abs() Decimal {
 
 // Pow returns the decimal to the inputted power
 func (d Decimal) Pow(exp int) Decimal {
-	return nanGuard(func() Decimal {
-		if exp == 0 {
-			return oneDecimal()
-		}
-
-		if d.EQ(oneDecimal()) {
-			return oneDecimal()
-		}
-
-		if exp < 0 {
-			if d.IsZero() {
-				return NaN
-			}
-
-			return oneDecimal().Div(d.pow(negativeExponentMagnitude(exp)))
-		}
-
-		return d.pow(uint(exp))
-	}, d)
-}
-
-func (d Decimal) pow(exp uint) Decimal {
-	return nanGuard(func() Decimal {
-		x := oneDecimal()
-		base := Decimal{fl: d.cpy()}
-
-		for exp > 0 {
-			if exp%2 == 1 {
-				x = x.Mul(base)
-			}
-
-			exp /= 2
-			if exp > 0 {
-				base = base.Mul(base)
-			}
-		}
-
-		return x
-	}, d)
+	return DefaultContext.Pow(d, exp)
 }
 
 func negativeExponentMagnitude(exp int) uint {
@@ -254,13 +205,7 @@ func negativeExponentMagnitude(exp int) uint {
 
 // Sqrt returns the decimal's square root
 func (d Decimal) Sqrt() Decimal {
-	return nanGuard(func() Decimal {
-		if d.LT(zeroDecimal()) {
-			return NaN
-		}
-
-		return Decimal{fl: d.cpy().Sqrt(d.cpy())}
-	}, d)
+	return DefaultContext.Sqrt(d)
 }
 
 // EQ returns true if this Decimal exactly equals the provided decimal.
@@ -356,12 +301,41 @@ func (d Decimal) IsZero() bool {
 	return d.value().Cmp(&flZero) == 0
 }
 
+// String returns the shortest decimal representation of this Decimal that parses back
+// to the same value at its precision. Numbers with a decimal exponent from -7 through 20
+// are written in plain notation, e.g. "123456789012.345", and others in exponential
+// notation, e.g. "1.5e+21" or "2.5e-8". NaN and the infinities are "NaN", "+Inf" and "-Inf".
+//
+// String is the canonical text representation of a Decimal, and is used by MarshalJSON,
+// Value and the %v verb.
 func (d Decimal) String() string {
 	if d.NaN() {
 		return "NaN"
+	} else if d.IsInf(0) {
+		return infText(d.value(), "+Inf", "-Inf")
+	}
+
+	text := d.value().Text('e', -1)
+	mantissa, exponent, _ := strings.Cut(text, "e")
+	exp, _ := strconv.Atoi(exponent)
+
+	if exp < -7 || exp > 20 {
+		return mantissa + "e" + exponent[:1] + strings.TrimLeft(exponent[1:], "0")
+	}
+
+	sign := ""
+	if strings.HasPrefix(mantissa, "-") {
+		sign, mantissa = "-", mantissa[1:]
+	}
+
+	digits := strings.Replace(mantissa, ".", "", 1)
+	if exp < 0 {
+		return sign + "0." + strings.Repeat("0", -exp-1) + digits
+	} else if len(digits) <= exp+1 {
+		return sign + digits + strings.Repeat("0", exp+1-len(digits))
 	}
 
-	return d.value().String()
+	return sign + digits[:exp+1] + "." + digits[exp+1:]
 }
 
 // FormattedString returns the string value of the number to the requested precision
@@ -383,7 +357,11 @@ func (d Decimal) MarshalJSON() ([]byte, error) {
 		return []byte("null"), nil
 	}
 
-	return d.value().MarshalText()
+	if d.IsInf(0) {
+		return []byte("\"" + d.String() + "\""), nil
+	}
+
+	return []byte(d.String()), nil
 }
 
 // UnmarshalJSON implements the json.Unmarshaler interface
@@ -399,12 +377,12 @@ func (d *Decimal) UnmarshalJSON(b []byte) error {
 		return nil
 	}
 
-	fl := newFloat(decimalPrecision(string(b)))
-	if _, _, err := fl.Parse(string(b), 10); err != nil {
+	parsed, err := Parse(string(b))
+	if err != nil {
 		return err
 	}
 
-	*d = Decimal{fl: fl}
+	*d = parsed
 	return nil
 }
 
@@ -415,6 +393,10 @@ func isQuoted(b []byte) bool {
 
 // Value implements the sql.Valuer interface
 func (d Decimal) Value() (driver.Value, error) {
+	if d.IsInf(0) {
+		return infText(d.value(), "Infinity", "-Infinity"), nil
+	}
+
 	return d.String(), nil
 }
 
@@ -439,10 +421,6 @@ func (d Decimal) cpy() *big.Float {
 	return cpy.Copy(val)
 }
 
-func resultFloat(extra uint, decimals ...Decimal) *big.Float {
-	return newFloat(maxPrecision(decimals...) + extra)
-}
-
 func maxPrecision(decimals ...Decimal) uint {
 	precision := minPrecision
 	for _, decimal := range decimals {
@@ -490,8 +468,8 @@ func anyNan(decimals ...Decimal) bool {
 }
 
 func nanGuard(yeildFunc func() Decimal, decimals ...Decimal) Decimal {
-	if anyNan(decimals...) {
-		return NaN
+	if nan, ok := firstNaN(decimals...); ok {
+		return nan
 	}
 
 	return yeildFunc()
@@ -2,6 +2,7 @@ package big
 
 import (
 	"encoding/json"
+	"fmt"
 	"math"
 	mathbig "math/big"
 	"strconv"
@@ -178,7 +179,7 @@ func TestDecimal_Add(t *testing.T) {
 	validateEqExamples(t,
 		equalExample{
 			value:    NewDecimal(3.14).Add(NewDecimal(2)),
-			expected: "5.14",
+			expected: "5.140000000000000124344978758017532527446746826171875",
 		},
 		equalExample{
 			value:    NaN.Add(NewDecimal(2)),
@@ -195,7 +196,7 @@ func TestDecimal_Sub(t *testing.T) {
 	validateEqExamples(t,
 		equalExample{
 			value:    NewDecimal(3.14).Sub(NewDecimal(2)),
-			expected: "1.14",
+			expected: "1.140000000000000124344978758017532527446746826171875",
 		},
 		equalExample{
 			value:    NaN.Sub(NewDecimal(1)),
@@ -212,7 +213,7 @@ func TestDecimal_Mul(t *testing.T) {
 	validateEqExamples(t,
 		equalExample{
 			value:    NewDecimal(3.14).Mul(TEN),
-			expected: "31.4",
+			expected: "31.40000000000000124344978758017532527446746826171875",
 		},
 		equalExample{
 			value:    NaN.Mul(TEN),
@@ -237,7 +238,7 @@ func TestDecimal_Div(t *testing.T) {
 	validateEqExamples(t,
 		equalExample{
 			value:    NewDecimal(3.14).Div(TEN),
-			expected: "0.314",
+			expected: "0.3140000000000000124344978758017532527446746826171875",
 		},
 		equalExample{
 			value:    TEN.Div(NaN),
@@ -424,7 +425,7 @@ func TestDecimal_Pow(t *testing.T) {
 		},
 		equalExample{
 			value:    ZERO.Pow(-1),
-			expected: "NaN",
+			expected: "+Inf",
 		},
 		equalExample{
 			value:    ONE.Pow(-int(^uint(0)>>1) - 1),
@@ -458,8 +459,40 @@ func TestDecimal_String(t *testing.T) {
 	validateEqExamples(t,
 		equalExample{
 			value:    NewDecimal(1.13),
+			expected: "1.12999999999999989341858963598497211933135986328125",
+		},
+		equalExample{
+			value:    NewFromString("1.13"),
 			expected: "1.13",
 		},
+		equalExample{
+			value:    NewFromString("123456789012.345"),
+			expected: "123456789012.345",
+		},
+		equalExample{
+			value:    NewFromString("-0.0000001"),
+			expected: "-0.0000001",
+		},
+		equalExample{
+			value:    NewFromString("0.00000001"),
+			expected: "1e-8",
+		},
+		equalExample{
+			value:    NewFromString("100000000000000000000"),
+			expected: "100000000000000000000",
+		},
+		equalExample{
+			value:    NewFromString("-1.5e21"),
+			expected: "-1.5e+21",
+		},
+		equalExample{
+			value:    NewFromString("2.5e-300"),
+			expected: "2.5e-300",
+		},
+		equalExample{
+			value:    NegInf(),
+			expected: "-Inf",
+		},
 		equalExample{
 			value:    NaN,
 			expected: "NaN",
@@ -469,6 +502,27 @@ func TestDecimal_String(t *testing.T) {
 			expected: "0",
 		},
 	)
+
+	t.Run("round trips", func(t *testing.T) {
+		for _, s := range []string{"123456789012.345", "0.1", "-9007199254740993", "1.000000000000000000000000000000000000000000000000000000000000000000001", "6.02214076e+23"} {
+			d := NewFromString(s)
+
+			assert.EqualValues(t, s, d.String())
+			assert.True(t, NewFromString(d.String()).EQ(d), s)
+
+			text, err := d.MarshalJSON()
+			assert.NoError(t, err)
+
+			var decoded Decimal
+			assert.NoError(t, decoded.UnmarshalJSON(text))
+			assert.EqualValues(t, s, decoded.String())
+
+			value, err := d.Value()
+			assert.NoError(t, err)
+			assert.EqualValues(t, s, value)
+			assert.EqualValues(t, s, fmt.Sprint(d))
+		}
+	})
 }
 
 func TestDecimal_FormattedString(t *testing.T) {
@@ -0,0 +1,136 @@
+package big
+
+// Variance returns the population variance of a slice of decimals, Σ(xᵢ - x̄)² / n,
+// computed in two passes. Like MaxSlice, it returns the first NaN in the slice if there
+// is one, and 0 for an empty slice.
+func Variance(decimals ...Decimal) Decimal {
+	return variance("Variance", decimals, 0)
+}
+
+// SampleVariance returns the sample variance of a slice of decimals, Σ(xᵢ - x̄)² / (n - 1),
+// which is NaN for a single value.
+func SampleVariance(decimals ...Decimal) Decimal {
+	return variance("SampleVariance", decimals, 1)
+}
+
+// StdDev returns the population standard deviation of a slice of decimals, the square
+// root of its Variance.
+func StdDev(decimals ...Decimal) Decimal {
+	return Variance(decimals...).Sqrt()
+}
+
+// SampleStdDev returns the sample standard deviation of a slice of decimals, the square
+// root of its SampleVariance.
+func SampleStdDev(decimals ...Decimal) Decimal {
+	return SampleVariance(decimals...).Sqrt()
+}
+
+// Covariance returns the population covariance of two slices of decimals,
+// Σ(xᵢ - x̄)(yᵢ - ȳ) / n. It is 0 for empty slices and NaN if they have different lengths.
+func Covariance(xs, ys []Decimal) Decimal {
+	return covariance("Covariance", xs, ys, 0)
+}
+
+// SampleCovariance returns the sample covariance of two slices of decimals,
+// Σ(xᵢ - x̄)(yᵢ - ȳ) / (n - 1), which is NaN for a single pair.
+func SampleCovariance(xs, ys []Decimal) Decimal {
+	return covariance("SampleCovariance", xs, ys, 1)
+}
+
+// Correlation returns the Pearson correlation coefficient of two slices of decimals, from
+// -1 to 1. It is NaN if either slice has no variance, or if they have different lengths.
+func Correlation(xs, ys []Decimal) Decimal {
+	if len(xs) != len(ys) {
+		return covariance("Correlation", xs, ys, 0)
+	} else if len(xs) == 0 {
+		return zeroDecimal()
+	}
+
+	// Taking a single square root of the product of the variances keeps perfectly
+	// correlated slices at exactly ±1.
+	return Covariance(xs, ys).Div(Variance(xs...).Mul(Variance(ys...)).Sqrt())
+}
+
+// Skewness returns the population skewness of a slice of decimals, m₃ / m₂^(3/2), where
+// mₖ is the kth central moment. It is NaN for a slice with no variance, and 0 for an empty
+// slice.
+func Skewness(decimals ...Decimal) Decimal {
+	if nan, ok := firstNaN(decimals...); ok {
+		return nan
+	} else if len(decimals) == 0 {
+		return zeroDecimal()
+	}
+
+	deviations := deviations(decimals)
+	m2 := centralMoment(deviations, 2)
+
+	return centralMoment(deviations, 3).Div(m2.Mul(m2.Sqrt()))
+}
+
+// Kurtosis returns the population excess kurtosis of a slice of decimals, m₄ / m₂² - 3,
+// where mₖ is the kth central moment, which is 0 for a normal distribution. It is NaN
+// for a slice with no variance, and 0 for an empty slice.
+func Kurtosis(decimals ...Decimal) Decimal {
+	if nan, ok := firstNaN(decimals...); ok {
+		return nan
+	} else if len(decimals) == 0 {
+		return zeroDecimal()
+	}
+
+	deviations := deviations(decimals)
+	m2 := centralMoment(deviations, 2)
+
+	return centralMoment(deviations, 4).Div(m2.Mul(m2)).Sub(NewFromInt(3))
+}
+
+// variance returns the sum of squared deviations of the decimals divided by n - ddof.
+func variance(op string, decimals []Decimal, ddof int) Decimal {
+	return covariance(op, decimals, decimals, ddof)
+}
+
+// covariance returns the sum of the products of the deviations of xs and ys divided by
+// n - ddof.
+func covariance(op string, xs, ys []Decimal, ddof int) Decimal {
+	operands := append(append([]Decimal{}, xs...), ys...)
+
+	if len(xs) != len(ys) {
+		return nanDecimal(ErrDomain, op, operands...)
+	} else if nan, ok := firstNaN(operands...); ok {
+		return nan
+	} else if len(xs) == 0 {
+		return zeroDecimal()
+	} else if len(xs) <= ddof {
+		return nanDecimal(ErrDomain, op, operands...)
+	}
+
+	xDeviations, yDeviations := deviations(xs), deviations(ys)
+
+	products := make([]Decimal, len(xs))
+	for i, deviation := range xDeviations {
+		products[i] = deviation.Mul(yDeviations[i])
+	}
+
+	return Sum(products...).Div(NewFromInt(len(xs) - ddof))
+}
+
+// deviations returns the differences between the decimals and their mean.
+func deviations(decimals []Decimal) []Decimal {
+	mean := Mean(decimals...)
+
+	deviations := make([]Decimal, len(decimals))
+	for i, decimal := range decimals {
+		deviations[i] = decimal.Sub(mean)
+	}
+
+	return deviations
+}
+
+// centralMoment returns the mean of the kth powers of the deviations.
+func centralMoment(deviations []Decimal, k int) Decimal {
+	powers := make([]Decimal, len(deviations))
+	for i, deviation := range deviations {
+		powers[i] = deviation.Pow(k)
+	}
+
+	return Mean(powers...)
+}
@@ -0,0 +1,164 @@
+package big
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func decimalsFromInts(ints ...int) []Decimal {
+	decimals := make([]Decimal, len(ints))
+	for i, n := range ints {
+		decimals[i] = NewFromInt(n)
+	}
+
+	return decimals
+}
+
+func TestVariance(t *testing.T) {
+	data := decimalsFromInts(2, 4, 4, 4, 5, 5, 7, 9)
+
+	validateEqExamples(t,
+		equalExample{
+			value:    Variance(data...),
+			expected: "4",
+		},
+		equalExample{
+			value:    StdDev(data...),
+			expected: "2",
+		},
+		equalExample{
+			value:    Variance(NewFromInt(7)),
+			expected: "0",
+		},
+		equalExample{
+			value:    Variance(ONE, NaN),
+			expected: "NaN",
+		},
+		equalExample{
+			value:    Variance(),
+			expected: "0",
+		},
+	)
+
+	t.Run("large offset", func(t *testing.T) {
+		offset := NewFromString("1e40")
+
+		shifted := make([]Decimal, len(data))
+		for i, d := range data {
+			shifted[i] = d.Add(offset)
+		}
+
+		assert.EqualValues(t, "4", Variance(shifted...).String())
+	})
+}
+
+func TestSampleVariance(t *testing.T) {
+	data := decimalsFromInts(2, 4, 4, 4, 5, 5, 7, 9)
+
+	assertDigits(t, "4.5714285714285714285714285714285714285714285714285714285714285714285714", SampleVariance(data...), 70)
+	assertDigits(t, "2.1380899352993950774764278470380281724320113187307011121735688384685915178896795", SampleStdDev(data...), 70)
+
+	assert.True(t, errors.Is(SampleVariance(ONE).NaNReason(), ErrDomain))
+	assert.True(t, SampleStdDev(ONE).NaN())
+	assert.True(t, SampleVariance().IsZero())
+}
+
+func TestCovariance(t *testing.T) {
+	xs := decimalsFromInts(1, 2, 3, 4, 5)
+	ys := decimalsFromInts(2, 4, 5, 4, 5)
+
+	validateEqExamples(t,
+		equalExample{
+			value:    Covariance(xs, ys),
+			expected: "1.2",
+		},
+		equalExample{
+			value:    SampleCovariance(xs, ys),
+			expected: "1.5",
+		},
+		equalExample{
+			value:    Covariance(xs, xs),
+			expected: "2",
+		},
+		equalExample{
+			value:    Covariance(nil, nil),
+			expected: "0",
+		},
+	)
+
+	assert.True(t, errors.Is(Covariance(xs, ys[1:]).NaNReason(), ErrDomain))
+	assert.True(t, errors.Is(SampleCovariance(xs[:1], ys[:1]).NaNReason(), ErrDomain))
+	assert.True(t, Covariance([]Decimal{NaN}, []Decimal{ONE}).NaN())
+}
+
+func TestCorrelation(t *testing.T) {
+	xs := decimalsFromInts(1, 2, 3, 4, 5)
+
+	assertDigits(t, "0.77459666924148337703585307995647992216658434105831816531751475322269661838739581", Correlation(xs, decimalsFromInts(2, 4, 5, 4, 5)), 70)
+
+	validateEqExamples(t,
+		equalExample{
+			value:    Correlation(xs, decimalsFromInts(10, 20, 30, 40, 50)),
+			expected: "1",
+		},
+		equalExample{
+			value:    Correlation(xs, decimalsFromInts(5, 4, 3, 2, 1)),
+			expected: "-1",
+		},
+		equalExample{
+			value:    Correlation(nil, nil),
+			expected: "0",
+		},
+	)
+
+	assert.True(t, errors.Is(Correlation(xs, decimalsFromInts(3, 3, 3, 3, 3)).NaNReason(), ErrDivisionByZero))
+	assert.True(t, errors.Is(Correlation(xs, xs[1:]).NaNReason(), ErrDomain))
+}
+
+func TestSkewness(t *testing.T) {
+	validateEqExamples(t,
+		equalExample{
+			value:    Skewness(decimalsFromInts(2, 4, 4, 4, 5, 5, 7, 9)...),
+			expected: "0.65625",
+		},
+		equalExample{
+			value:    Skewness(decimalsFromInts(1, 2, 3)...),
+			expected: "0",
+		},
+		equalExample{
+			value:    Skewness(ONE, ONE),
+			expected: "NaN",
+		},
+		equalExample{
+			value:    Skewness(NaN),
+			expected: "NaN",
+		},
+		equalExample{
+			value:    Skewness(),
+			expected: "0",
+		},
+	)
+}
+
+func TestKurtosis(t *testing.T) {
+	validateEqExamples(t,
+		equalExample{
+			value:    Kurtosis(decimalsFromInts(2, 4, 4, 4, 5, 5, 7, 9)...),
+			expected: "-0.21875",
+		},
+		equalExample{
+			value:    Kurtosis(decimalsFromInts(-1, 1)...),
+			expected: "-2",
+		},
+		equalExample{
+			value:    Kurtosis(ONE, ONE),
+			expected: "NaN",
+		},
+		equalExample{
+			value:    Kurtosis(),
+			expected: "0",
+		},
+	)
+}
@@ -0,0 +1,77 @@
+package big
+
+// EMA is an exponential moving average, which weights each value pushed to it by alpha
+// and the average of the values before it by 1 - alpha. It is seeded with the first value
+// pushed, and its value before then is 0.
+//
+// Like Stats, an EMA rounds its average to 64 bits more than the largest precision pushed
+// so far, so precision does not grow with the number of values. The zero value is not
+// usable, and its value is NaN; create an EMA with NewEMA or NewEMAPeriod. An EMA is not
+// safe for concurrent use.
+type EMA struct {
+	alpha     Decimal
+	value     Decimal
+	count     int64
+	precision uint
+}
+
+// NewEMA creates a new EMA with the given smoothing factor. Its value is NaN unless alpha
+// is greater than 0 and at most 1.
+func NewEMA(alpha Decimal) *EMA {
+	if !alpha.NaN() && (alpha.Sign() <= 0 || alpha.GT(oneDecimal())) {
+		alpha = nanDecimal(ErrDomain, "NewEMA", alpha)
+	}
+
+	return &EMA{alpha: alpha}
+}
+
+// NewEMAPeriod creates a new EMA over the given number of periods, with a smoothing
+// factor of 2 / (period + 1). Its value is NaN unless period is at least 1.
+func NewEMAPeriod(period int) *EMA {
+	if period < 1 {
+		return &EMA{alpha: nanDecimal(ErrDomain, "NewEMAPeriod", NewFromInt(period))}
+	}
+
+	return &EMA{alpha: DefaultContext.Div(NewFromInt(2), NewFromInt64(int64(period)+1))}
+}
+
+// Push adds a value to the EMA.
+func (e *EMA) Push(value Decimal) {
+	e.count++
+
+	if !value.NaN() {
+		e.precision = max(e.precision, value.value().Prec())
+	}
+
+	if e.count == 1 {
+		e.value = value
+		return
+	}
+
+	c := boundedContext(e.precision)
+	e.value = c.Add(e.value, c.Mul(e.alpha, c.Sub(value, e.value)))
+}
+
+// Alpha returns the smoothing factor of the EMA.
+func (e *EMA) Alpha() Decimal {
+	return e.alpha
+}
+
+// Count returns the number of values pushed to the EMA, including any NaN.
+func (e *EMA) Count() int64 {
+	return e.count
+}
+
+// Value returns the exponential moving average of the values pushed to the EMA.
+func (e *EMA) Value() Decimal {
+	switch {
+	case e.alpha.NaN():
+		return e.alpha
+	case e.alpha.IsZero():
+		return nanDecimal(ErrDomain, "EMA", e.alpha)
+	case e.count == 0:
+		return zeroDecimal()
+	}
+
+	return e.value
+}
@@ -0,0 +1,83 @@
+package big
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEMA(t *testing.T) {
+	e := NewEMAPeriod(3)
+
+	assert.EqualValues(t, "0.5", e.Alpha().String())
+	assert.True(t, e.Value().IsZero())
+
+	var values []string
+	for _, value := range decimalsFromInts(1, 2, 3, 4) {
+		e.Push(value)
+		values = append(values, e.Value().String())
+	}
+
+	assert.EqualValues(t, []string{"1", "1.5", "2.25", "3.125"}, values)
+	assert.EqualValues(t, 4, e.Count())
+
+	e = NewEMA(NewFromString("0.1"))
+	for _, value := range decimalsFromInts(10, 20, 30) {
+		e.Push(value)
+	}
+
+	assertDigits(t, "12.9", e.Value(), 70)
+
+	e = NewEMAPeriod(10)
+	for _, value := range decimalsFromInts(22, 24, 23) {
+		e.Push(value)
+	}
+
+	assertDigits(t, "22.479338842975206611570247933884297520661157024793388429752066115702479", e.Value(), 70)
+}
+
+func TestEMA_BoundedPrecision(t *testing.T) {
+	e := NewEMAPeriod(9)
+	for i := 0; i < 1000; i++ {
+		e.Push(NewFromString("0.1"))
+		e.Push(NewFromString("0.3"))
+	}
+
+	assert.LessOrEqual(t, e.Value().Precision(), minPrecision+guardPrecision)
+	assertDigits(t, "0.2", e.Value().Round(1), 10)
+}
+
+func TestEMA_Invalid(t *testing.T) {
+	for _, e := range []*EMA{NewEMA(ZERO), NewEMA(NewFromString("1.5")), NewEMA(NewFromString("-0.5")), NewEMAPeriod(0)} {
+		e.Push(ONE)
+		assert.True(t, errors.Is(e.Value().NaNReason(), ErrDomain))
+	}
+
+	assert.True(t, NewEMA(ONE).Value().IsZero())
+
+	var zero EMA
+	assert.True(t, errors.Is(zero.Value().NaNReason(), ErrDomain))
+	zero.Push(ONE)
+	zero.Push(TEN)
+	assert.True(t, errors.Is(zero.Value().NaNReason(), ErrDomain))
+
+	e := NewEMAPeriod(2)
+	e.Push(ONE)
+	e.Push(NaN)
+	e.Push(TEN)
+	assert.True(t, e.Value().NaN())
+}
+
+func TestEMA_ExportedSentinels(t *testing.T) {
+	oldOne := ONE
+	t.Cleanup(func() {
+		ONE = oldOne
+	})
+
+	ONE = TEN
+
+	e := NewEMA(NewFromInt(5))
+	e.Push(NewFromInt(1))
+	assert.True(t, errors.Is(e.Value().NaNReason(), ErrDomain))
+}
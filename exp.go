@@ -0,0 +1,299 @@
+package big
+
+import (
+	"math"
+	"math/big"
+	"math/bits"
+	"sync"
+)
+
+// guardPrecision is the number of extra bits carried through transcendental
+// computations so that the final rounding to the result precision is correct.
+const guardPrecision uint = 64
+
+var ln2Constant = &constant{compute: func(prec uint) *big.Float {
+	// ln 2 = 2 atanh(1/3)
+	third := newFloatPrec(prec).Quo(newFloatPrec(prec).SetInt64(1), newFloatPrec(prec).SetInt64(3))
+	sum := atanhSeries(third, prec)
+	return sum.SetMantExp(sum, 1)
+}}
+
+// Exp returns e raised to the power of x. It is +Inf when the result overflows and 0
+// when it underflows.
+func (c Context) Exp(x Decimal) Decimal {
+	return nanGuard(func() Decimal {
+		if x.IsInf(1) {
+			return PosInf()
+		} else if x.IsInf(-1) {
+			return zeroDecimal()
+		}
+
+		z := c.newFloat(x.value().Prec())
+		return Decimal{fl: z.Set(expFloat(x.value(), z.Prec()))}
+	}, x)
+}
+
+// Ln returns the natural logarithm of x. It is -Inf for zero and NaN for negative numbers.
+func (c Context) Ln(x Decimal) Decimal {
+	return nanGuard(func() Decimal {
+		if special, ok := logSpecialCase("Ln", x); ok {
+			return special
+		}
+
+		z := c.newFloat(x.value().Prec())
+		return Decimal{fl: z.Set(lnFloat(x.value(), z.Prec()))}
+	}, x)
+}
+
+// Log10 returns the decimal logarithm of x. Exact powers of ten have exact logarithms.
+func (c Context) Log10(x Decimal) Decimal {
+	return nanGuard(func() Decimal {
+		if special, ok := logSpecialCase("Log10", x); ok {
+			return special
+		}
+
+		z := c.newFloat(x.value().Prec())
+		return Decimal{fl: z.Set(logFloat(x.value(), newFloatPrec(64).SetInt64(10), z.Prec()))}
+	}, x)
+}
+
+// Log2 returns the binary logarithm of x. Exact powers of two have exact logarithms.
+func (c Context) Log2(x Decimal) Decimal {
+	return nanGuard(func() Decimal {
+		if special, ok := logSpecialCase("Log2", x); ok {
+			return special
+		}
+
+		z := c.newFloat(x.value().Prec())
+		return Decimal{fl: z.Set(logFloat(x.value(), newFloatPrec(64).SetInt64(2), z.Prec()))}
+	}, x)
+}
+
+// Log returns the logarithm of x in the given base, which must be positive, finite and
+// not equal to one.
+func (c Context) Log(x, base Decimal) Decimal {
+	return nanGuard(func() Decimal {
+		if !base.finite() || base.Sign() <= 0 || base.EQ(oneDecimal()) {
+			return nanDecimal(ErrDomain, "Log", x, base)
+		}
+
+		if special, ok := logSpecialCase("Log", x, base); ok {
+			if base.LT(oneDecimal()) && special.IsInf(0) {
+				return special.Neg()
+			}
+
+			return special
+		}
+
+		z := c.newFloat(max(x.value().Prec(), base.value().Prec()))
+		return Decimal{fl: z.Set(logFloat(x.value(), base.value(), z.Prec()))}
+	}, x, base)
+}
+
+// Exp returns e raised to the power of this Decimal.
+func (d Decimal) Exp() Decimal {
+	return DefaultContext.Exp(d)
+}
+
+// Ln returns the natural logarithm of this Decimal.
+func (d Decimal) Ln() Decimal {
+	return DefaultContext.Ln(d)
+}
+
+// Log10 returns the decimal logarithm of this Decimal.
+func (d Decimal) Log10() Decimal {
+	return DefaultContext.Log10(d)
+}
+
+// Log2 returns the binary logarithm of this Decimal.
+func (d Decimal) Log2() Decimal {
+	return DefaultContext.Log2(d)
+}
+
+// Log returns the logarithm of this Decimal in the given base.
+func (d Decimal) Log(base Decimal) Decimal {
+	return DefaultContext.Log(d, base)
+}
+
+// logSpecialCase returns the logarithm of the non-finite, non-positive and unit
+// arguments, which are the same in every base greater than one.
+func logSpecialCase(op string, x Decimal, operands ...Decimal) (Decimal, bool) {
+	switch {
+	case x.Sign() < 0:
+		return nanDecimal(ErrDomain, op, append([]Decimal{x}, operands...)...), true
+	case x.IsZero():
+		return NegInf(), true
+	case x.IsInf(1):
+		return PosInf(), true
+	case x.EQ(oneDecimal()):
+		return zeroDecimal(), true
+	}
+
+	return Decimal{}, false
+}
+
+// expFloat returns e^x, for finite x, to prec bits.
+func expFloat(x *big.Float, prec uint) *big.Float {
+	if x.Sign() == 0 {
+		return newFloatPrec(prec).SetInt64(1)
+	}
+
+	// e^x overflows or underflows the exponent range of a big.Float beyond |x| = ln 2^31.
+	approx, _ := x.Float64()
+	if approx > 1.4886e9 {
+		return newFloatPrec(prec).SetInf(false)
+	} else if approx < -1.4886e9 {
+		return newFloatPrec(prec)
+	}
+
+	// x = k ln 2 + r, with |r| <= ln 2 / 2, so e^x = 2^k e^r. e^r is then computed from the
+	// Taylor series of e^(r / 2^steps), squared steps times.
+	k := int64(math.Round(approx / math.Ln2))
+	steps := reductionSteps(prec)
+	wp := prec + guardPrecision + steps
+
+	r := newFloatPrec(wp + 64).Set(x)
+	if k != 0 {
+		kln2 := newFloatPrec(wp + 64).SetInt64(k)
+		r.Sub(r, kln2.Mul(kln2, ln2Constant.get(wp+64)))
+	}
+	r.SetPrec(wp)
+	r.SetMantExp(r, -int(steps))
+
+	sum := newFloatPrec(wp).SetInt64(1)
+	term := newFloatPrec(wp).SetInt64(1)
+	for n := int64(1); ; n++ {
+		term.Mul(term, r)
+		term.Quo(term, newFloatPrec(wp).SetInt64(n))
+
+		if negligible(term, sum, wp) {
+			break
+		}
+
+		sum.Add(sum, term)
+	}
+
+	for i := uint(0); i < steps; i++ {
+		sum.Mul(sum, sum)
+	}
+
+	sum.SetMantExp(sum, int(k/2))
+	return sum.SetMantExp(sum, int(k-k/2))
+}
+
+// lnFloat returns ln(x), for finite x > 0, to prec bits.
+func lnFloat(x *big.Float, prec uint) *big.Float {
+	wp := prec + guardPrecision
+
+	// x = m × 2^k, with 1/√2 <= m < √2, so ln x = ln m + k ln 2.
+	m := new(big.Float)
+	k := x.MantExp(m)
+	m.SetPrec(wp)
+	if m.Cmp(big.NewFloat(math.Sqrt2/2)) < 0 {
+		m.SetMantExp(m, 1)
+		k--
+	}
+
+	one := newFloatPrec(wp).SetInt64(1)
+	lnm := newFloatPrec(wp)
+
+	if delta := newFloatPrec(wp).Sub(m, one); delta.Sign() != 0 {
+		// Taking square roots until m is within 2^-steps of one makes the series
+		// converge quickly: ln m = 2^steps × ln(m^(1/2^steps)).
+		steps := uint(0)
+		if magnitude := -delta.MantExp(nil); magnitude < int(reductionSteps(prec)) {
+			steps = reductionSteps(prec) - uint(magnitude)
+			wp += 2 * steps
+			m.SetPrec(wp)
+			one.SetPrec(wp)
+		}
+
+		for i := uint(0); i < steps; i++ {
+			m.Sqrt(m)
+		}
+
+		numerator := newFloatPrec(wp).Sub(m, one)
+		denominator := newFloatPrec(wp).Add(m, one)
+		lnm = atanhSeries(numerator.Quo(numerator, denominator), wp)
+		lnm.SetMantExp(lnm, int(steps)+1)
+	}
+
+	if k == 0 {
+		return lnm
+	}
+
+	kln2 := newFloatPrec(wp + uint(bits.Len64(uint64(abs64(int64(k)))))).SetInt64(int64(k))
+	kln2.Mul(kln2, ln2Constant.get(kln2.Prec()))
+
+	return kln2.Add(kln2, lnm)
+}
+
+// logFloat returns the logarithm of x, for finite x > 0, in the given finite base > 0,
+// to prec bits.
+func logFloat(x, base *big.Float, prec uint) *big.Float {
+	wp := prec + guardPrecision
+
+	quotient := lnFloat(x, wp)
+	return quotient.Quo(quotient, lnFloat(base, wp))
+}
+
+// atanhSeries returns atanh(z) = z + z^3/3 + z^5/5 + ..., for |z| < 1, to prec bits.
+func atanhSeries(z *big.Float, prec uint) *big.Float {
+	sum := newFloatPrec(prec).Set(z)
+	power := newFloatPrec(prec).Set(z)
+	square := newFloatPrec(prec).Mul(z, z)
+
+	for n := int64(3); ; n += 2 {
+		power.Mul(power, square)
+		term := newFloatPrec(prec).Quo(power, newFloatPrec(prec).SetInt64(n))
+
+		if negligible(term, sum, prec) {
+			return sum
+		}
+
+		sum.Add(sum, term)
+	}
+}
+
+// negligible reports whether adding term to sum would no longer change sum at prec bits.
+func negligible(term, sum *big.Float, prec uint) bool {
+	return term.Sign() == 0 || term.MantExp(nil) < sum.MantExp(nil)-int(prec)-1
+}
+
+// reductionSteps returns how many times a series argument should be halved before
+// evaluating a series to prec bits, balancing the cost of the reduction against the
+// number of series terms.
+func reductionSteps(prec uint) uint {
+	return uint(math.Sqrt(float64(prec)))/2 + 1
+}
+
+func newFloatPrec(prec uint) *big.Float {
+	return new(big.Float).SetPrec(prec).SetMode(big.ToNearestEven)
+}
+
+func abs64(n int64) int64 {
+	if n < 0 {
+		return -n
+	}
+
+	return n
+}
+
+// constant caches the value of a mathematical constant at the highest precision it has
+// been requested at.
+type constant struct {
+	mu      sync.Mutex
+	value   *big.Float
+	compute func(prec uint) *big.Float
+}
+
+func (c *constant) get(prec uint) *big.Float {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.value == nil || c.value.Prec() < prec+guardPrecision {
+		c.value = c.compute(prec + guardPrecision)
+	}
+
+	return newFloatPrec(prec).Set(c.value)
+}
@@ -0,0 +1,186 @@
+package big
+
+import (
+	"errors"
+	mathbig "math/big"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	eDigits    = "2.718281828459045235360287471352662497757247093699959574966967627724076630353547594571382178525166427"
+	ln2Digits  = "0.6931471805599453094172321214581765680755001343602552541206800094933936219696947156058633269964186875"
+	ln10Digits = "2.302585092994045684017991454684364207601101488628772976033"
+)
+
+func assertDigits(t *testing.T, expected string, d Decimal, digits int) {
+	t.Helper()
+
+	assert.EqualValues(t, NewFromString(expected).value().Text('g', digits), d.value().Text('g', digits))
+}
+
+func TestDecimal_Exp(t *testing.T) {
+	assertDigits(t, eDigits, ONE.Exp(), 75)
+	assertDigits(t, "0.3678794411714423215955237701614608674458111310317678345078368016974614957448998033571472743459196437", ONE.Neg().Exp(), 75)
+	assertDigits(t, "22026.46579480671651695790064528424436635351261855678107423542635522520281857079257519912096816452590", TEN.Exp(), 75)
+	assertDigits(t, "1.000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000001", NewFromString("1e-126").Exp(), 70)
+
+	validateEqExamples(t,
+		equalExample{
+			value:    ZERO.Exp(),
+			expected: "1",
+		},
+		equalExample{
+			value:    PosInf().Exp(),
+			expected: "+Inf",
+		},
+		equalExample{
+			value:    NegInf().Exp(),
+			expected: "0",
+		},
+		equalExample{
+			value:    NewFromString("1e10").Exp(),
+			expected: "+Inf",
+		},
+		equalExample{
+			value:    NewFromString("-1e10").Exp(),
+			expected: "0",
+		},
+		equalExample{
+			value:    NaN.Exp(),
+			expected: "NaN",
+		},
+	)
+
+	t.Run("large exponents", func(t *testing.T) {
+		assertDigits(t, "3.0332153968020875450864021414181143270839737948134774096061949997862e434294", NewFromInt(1000000).Exp(), 60)
+	})
+}
+
+func TestDecimal_Ln(t *testing.T) {
+	assertDigits(t, ln2Digits, NewFromInt(2).Ln(), 75)
+	assertDigits(t, ln10Digits, TEN.Ln(), 55)
+	assertDigits(t, "-"+ln10Digits, NewFromString("0.1").Ln(), 55)
+
+	nearOne := NewFromString("1." + strings.Repeat("0", 99) + "1")
+	assert.EqualValues(t, nearOne.Sub(ONE).value().Text('g', 70), nearOne.Ln().value().Text('g', 70))
+
+	assertDigits(t, "2302.585092994045684017991454684364207601101488628772976033", NewFromString("1e1000").Ln(), 55)
+
+	validateEqExamples(t,
+		equalExample{
+			value:    ONE.Ln(),
+			expected: "0",
+		},
+		equalExample{
+			value:    ZERO.Ln(),
+			expected: "-Inf",
+		},
+		equalExample{
+			value:    PosInf().Ln(),
+			expected: "+Inf",
+		},
+		equalExample{
+			value:    NaN.Ln(),
+			expected: "NaN",
+		},
+	)
+
+	t.Run("domain", func(t *testing.T) {
+		assert.True(t, errors.Is(ONE.Neg().Ln().NaNReason(), ErrDomain))
+		assert.True(t, errors.Is(NegInf().Ln().NaNReason(), ErrDomain))
+	})
+
+	t.Run("inverts Exp", func(t *testing.T) {
+		for _, s := range []string{"0.5", "3.75", "-12.125", "100"} {
+			x := NewFromString(s)
+			assertDigits(t, s, x.Exp().Ln(), 70)
+		}
+	})
+}
+
+func TestDecimal_Log10(t *testing.T) {
+	validateEqExamples(t,
+		equalExample{
+			value:    NewFromInt(1000).Log10(),
+			expected: "3",
+		},
+		equalExample{
+			value:    NewFromString("0.001").Log10(),
+			expected: "-3",
+		},
+		equalExample{
+			value:    ZERO.Log10(),
+			expected: "-Inf",
+		},
+		equalExample{
+			value:    ONE.Neg().Log10(),
+			expected: "NaN",
+		},
+	)
+
+	assert.True(t, NewFromString("1e300").Log10().EQ(NewFromInt(300)))
+	assertDigits(t, "0.3010299956639811952137388947244930267681898814621085413104274611271081892744245094869272521181861720", NewFromInt(2).Log10(), 75)
+}
+
+func TestDecimal_Log2(t *testing.T) {
+	validateEqExamples(t,
+		equalExample{
+			value:    NewFromInt(1024).Log2(),
+			expected: "10",
+		},
+		equalExample{
+			value:    NewFromString("0.125").Log2(),
+			expected: "-3",
+		},
+		equalExample{
+			value:    ONE.Neg().Log2(),
+			expected: "NaN",
+		},
+	)
+
+	assertDigits(t, "3.321928094887362347870319429489390175864831393024580612054756395815934776608625215850139743359370155", TEN.Log2(), 75)
+}
+
+func TestDecimal_Log(t *testing.T) {
+	validateEqExamples(t,
+		equalExample{
+			value:    NewFromInt(81).Log(NewFromInt(3)),
+			expected: "4",
+		},
+		equalExample{
+			value:    NewFromInt(8).Log(NewFromString("0.5")),
+			expected: "-3",
+		},
+		equalExample{
+			value:    ZERO.Log(NewFromString("0.5")),
+			expected: "+Inf",
+		},
+		equalExample{
+			value:    PosInf().Log(NewFromString("0.5")),
+			expected: "-Inf",
+		},
+	)
+
+	for _, base := range []Decimal{ONE, ZERO, ONE.Neg(), PosInf(), NaN} {
+		result := TEN.Log(base)
+
+		assert.True(t, result.NaN(), base.String())
+	}
+
+	assert.True(t, errors.Is(TEN.Log(ONE).NaNReason(), ErrDomain))
+}
+
+func TestContext_Exp(t *testing.T) {
+	ctx := NewContextDigits(50, mathbig.ToNearestEven)
+	e := ctx.Exp(ONE)
+
+	assert.EqualValues(t, 167, e.value().Prec())
+	assertDigits(t, eDigits, e, 50)
+	assert.EqualValues(t, 167, ctx.Ln(TEN).value().Prec())
+	assert.EqualValues(t, 167, ctx.Log10(TEN).value().Prec())
+	assert.EqualValues(t, 167, ctx.Log2(TEN).value().Prec())
+	assert.EqualValues(t, 167, ctx.Log(TEN, NewFromInt(3)).value().Prec())
+}
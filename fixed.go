@@ -0,0 +1,412 @@
+package big
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// FixedNaN is a Fixed that is not a number.
+var FixedNaN = Fixed{nan: true}
+
+// Fixed is an immutable, exact base-10 number with the value coef × 10^-scale. Unlike
+// Decimal, which approximates numbers in binary, Fixed represents every decimal number
+// exactly, and keeps its scale, so "12.30" is written back as "12.30".
+//
+// Add, Sub and Mul are exact. Div and Rescale round to an explicit scale with an explicit
+// rounding mode. The zero value is 0 at scale 0.
+type Fixed struct {
+	coef  *big.Int
+	scale int32
+	nan   bool
+}
+
+// NewFixed creates a new Fixed with the value coef × 10^-scale, so NewFixed(1230, 2) is
+// 12.30.
+func NewFixed(coef int64, scale int32) Fixed {
+	return Fixed{coef: big.NewInt(coef), scale: scale}
+}
+
+// NewFixedFromBigInt creates a new Fixed with the value coef × 10^-scale. A nil coef is
+// NaN.
+func NewFixedFromBigInt(coef *big.Int, scale int32) Fixed {
+	if coef == nil {
+		return Fixed{nan: true}
+	}
+
+	return Fixed{coef: new(big.Int).Set(coef), scale: scale}
+}
+
+// NewFixedFromDecimal creates a new Fixed from the shortest decimal representation of d,
+// which is the text String writes. NaN and the infinities are NaN.
+func NewFixedFromDecimal(d Decimal) Fixed {
+	if !d.finite() {
+		return Fixed{nan: true}
+	}
+
+	coef, exp := d.decimalParts()
+	if exp < math.MinInt32 || exp > math.MaxInt32 {
+		return Fixed{nan: true}
+	}
+
+	if exp > 0 {
+		return Fixed{coef: coef.Mul(coef, pow10(exp))}
+	}
+
+	return Fixed{coef: coef, scale: int32(-exp)}
+}
+
+// NewFixedFromString creates a new Fixed from a string value. It returns NaN if the string
+// cannot be parsed; use ParseFixed to find out why.
+func NewFixedFromString(str string) Fixed {
+	f, _ := ParseFixed(str)
+	return f
+}
+
+// ParseFixed creates a new Fixed from a string value, returning a *ParseError if the
+// string is not a valid number. The scale of the result is the number of digits after
+// the decimal point, adjusted by any exponent, so "1.50" has scale 2 and "1.5e3" has
+// scale -2.
+//
+// ParseFixed accepts the syntax Parse does, except for infinities and binary exponents.
+func ParseFixed(str string) (Fixed, error) {
+	if str == "NaN" {
+		return Fixed{nan: true}, nil
+	}
+
+	exponentOffset, infinite, err := scanDecimal(str)
+	switch {
+	case err != nil:
+		return Fixed{nan: true}, err
+	case infinite:
+		return Fixed{nan: true}, &ParseError{Input: str, Offset: 0, Reason: "infinity is not a fixed-point number", Err: ErrRange}
+	}
+
+	mantissa, exponent := str[:exponentOffset], str[exponentOffset:]
+
+	exp := int64(0)
+	if exponent != "" {
+		if exponent[0] == 'p' || exponent[0] == 'P' {
+			return Fixed{nan: true}, &ParseError{Input: str, Offset: exponentOffset, Reason: "binary exponent in fixed-point number", Err: ErrSyntax}
+		}
+
+		if exp, err = strconv.ParseInt(exponent[1:], 10, 32); err != nil {
+			return Fixed{nan: true}, &ParseError{Input: str, Offset: exponentOffset, Reason: "exponent out of range", Err: ErrRange}
+		}
+	}
+
+	whole, frac, _ := strings.Cut(mantissa, ".")
+	coef, _ := new(big.Int).SetString(whole+frac, 10)
+
+	scale := int64(len(frac)) - exp
+	if scale < math.MinInt32 || scale > math.MaxInt32 {
+		return Fixed{nan: true}, &ParseError{Input: str, Offset: exponentOffset, Reason: "exponent out of range", Err: ErrRange}
+	}
+
+	return Fixed{coef: coef, scale: int32(scale)}, nil
+}
+
+// Decimal returns this Fixed as a Decimal, which is the Decimal NewFromString produces
+// for its text.
+func (f Fixed) Decimal() Decimal {
+	return NewFromString(f.String())
+}
+
+// Add returns the exact sum of this Fixed and the addend, at the larger of their scales.
+func (f Fixed) Add(addend Fixed) Fixed {
+	if f.nan || addend.nan {
+		return Fixed{nan: true}
+	}
+
+	a, b, scale := alignFixed(f, addend)
+	return Fixed{coef: a.Add(a, b), scale: scale}
+}
+
+// Sub returns the exact difference of this Fixed and the subtrahend, at the larger of
+// their scales.
+func (f Fixed) Sub(subtrahend Fixed) Fixed {
+	if f.nan || subtrahend.nan {
+		return Fixed{nan: true}
+	}
+
+	a, b, scale := alignFixed(f, subtrahend)
+	return Fixed{coef: a.Sub(a, b), scale: scale}
+}
+
+// Mul returns the exact product of this Fixed and the factor, at the sum of their scales.
+// The product is NaN if that scale overflows an int32.
+func (f Fixed) Mul(factor Fixed) Fixed {
+	scale := int64(f.scale) + int64(factor.scale)
+	if f.nan || factor.nan || scale < math.MinInt32 || scale > math.MaxInt32 {
+		return Fixed{nan: true}
+	}
+
+	return Fixed{coef: new(big.Int).Mul(f.coefficient(), factor.coefficient()), scale: int32(scale)}
+}
+
+// Div returns the quotient of this Fixed and the divisor at the given scale, rounded
+// using the given rounding mode. Dividing by zero is NaN.
+func (f Fixed) Div(divisor Fixed, scale int32, mode RoundingMode) Fixed {
+	if f.nan || divisor.nan || divisor.IsZero() {
+		return Fixed{nan: true}
+	}
+
+	// f / divisor = (a × 10^-sa) / (b × 10^-sb), so the quotient's coefficient at the
+	// requested scale is a × 10^(scale - sa + sb) / b.
+	num := new(big.Int).Set(f.coefficient())
+	den := new(big.Int).Set(divisor.coefficient())
+
+	if shift := int(scale) - int(f.scale) + int(divisor.scale); shift >= 0 {
+		num.Mul(num, pow10(shift))
+	} else {
+		den.Mul(den, pow10(-shift))
+	}
+
+	if den.Sign() < 0 {
+		num.Neg(num)
+		den.Neg(den)
+	}
+
+	return Fixed{coef: roundQuo(num, den, mode), scale: scale}
+}
+
+// Rescale returns this Fixed at the given scale, rounded using the given rounding mode
+// if the scale is smaller than this Fixed's.
+func (f Fixed) Rescale(scale int32, mode RoundingMode) Fixed {
+	if f.nan {
+		return f
+	}
+
+	shift := int(scale) - int(f.scale)
+	if shift >= 0 {
+		return Fixed{coef: new(big.Int).Mul(f.coefficient(), pow10(shift)), scale: scale}
+	}
+
+	return Fixed{coef: roundQuo(f.coefficient(), pow10(-shift), mode), scale: scale}
+}
+
+// Neg returns this Fixed negated.
+func (f Fixed) Neg() Fixed {
+	if f.nan {
+		return f
+	}
+
+	return Fixed{coef: new(big.Int).Neg(f.coefficient()), scale: f.scale}
+}
+
+// Abs returns the absolute value of this Fixed.
+func (f Fixed) Abs() Fixed {
+	if f.nan {
+		return f
+	}
+
+	return Fixed{coef: new(big.Int).Abs(f.coefficient()), scale: f.scale}
+}
+
+// Scale returns the scale of this Fixed, the number of digits after its decimal point.
+func (f Fixed) Scale() int32 {
+	return f.scale
+}
+
+// Coefficient returns the coefficient of this Fixed, whose value is coef × 10^-scale, or
+// nil for NaN.
+func (f Fixed) Coefficient() *big.Int {
+	if f.nan {
+		return nil
+	}
+
+	return new(big.Int).Set(f.coefficient())
+}
+
+// Sign returns -1, 0 or 1 when this Fixed is negative, zero or positive, and 0 for NaN.
+func (f Fixed) Sign() int {
+	if f.nan {
+		return 0
+	}
+
+	return f.coefficient().Sign()
+}
+
+// EQ returns true if this Fixed equals the provided Fixed, regardless of their scales.
+func (f Fixed) EQ(other Fixed) bool {
+	if f.nan || other.nan {
+		return false
+	}
+
+	return f.Cmp(other) == 0
+}
+
+// LT returns true if this Fixed is less than the provided Fixed.
+func (f Fixed) LT(other Fixed) bool {
+	if f.nan || other.nan {
+		return false
+	}
+
+	return f.Cmp(other) < 0
+}
+
+// LTE returns true if this Fixed is less than or equal to the provided Fixed.
+func (f Fixed) LTE(other Fixed) bool {
+	if f.nan || other.nan {
+		return false
+	}
+
+	return f.Cmp(other) <= 0
+}
+
+// GT returns true if this Fixed is greater than the provided Fixed.
+func (f Fixed) GT(other Fixed) bool {
+	if f.nan || other.nan {
+		return false
+	}
+
+	return f.Cmp(other) > 0
+}
+
+// GTE returns true if this Fixed is greater than or equal to the provided Fixed.
+func (f Fixed) GTE(other Fixed) bool {
+	if f.nan || other.nan {
+		return false
+	}
+
+	return f.Cmp(other) >= 0
+}
+
+// Cmp will return 1 if this Fixed is greater than the provided, 0 if they are the same,
+// and -1 if it is less. Like Decimal, NaN sorts below every number.
+func (f Fixed) Cmp(other Fixed) int {
+	switch {
+	case f.nan && other.nan:
+		return 0
+	case f.nan:
+		return -1
+	case other.nan:
+		return 1
+	}
+
+	a, b, _ := alignFixed(f, other)
+	return a.Cmp(b)
+}
+
+// Float will return this Fixed as a float value.
+// Note that there may be some loss of precision in this operation.
+func (f Fixed) Float() float64 {
+	if f.nan {
+		return math.NaN()
+	}
+
+	return f.Decimal().Float()
+}
+
+// NaN returns true if this Fixed is not a valid number
+func (f Fixed) NaN() bool {
+	return f.nan
+}
+
+// IsZero will return true if this Fixed is equal to 0.
+func (f Fixed) IsZero() bool {
+	return !f.nan && f.coefficient().Sign() == 0
+}
+
+// String returns this Fixed in plain decimal notation with exactly Scale digits after
+// the decimal point, or "NaN".
+func (f Fixed) String() string {
+	if f.nan {
+		return "NaN"
+	}
+
+	if f.scale <= 0 {
+		return new(big.Int).Mul(f.coefficient(), pow10(-int(f.scale))).String()
+	}
+
+	digits := new(big.Int).Abs(f.coefficient()).String()
+	scale := int(f.scale)
+
+	if len(digits) <= scale {
+		digits = strings.Repeat("0", scale-len(digits)+1) + digits
+	}
+
+	digits = digits[:len(digits)-scale] + "." + digits[len(digits)-scale:]
+	if f.coefficient().Sign() < 0 {
+		return "-" + digits
+	}
+
+	return digits
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (f Fixed) MarshalJSON() ([]byte, error) {
+	if MarshalQuoted {
+		return []byte("\"" + f.String() + "\""), nil
+	}
+
+	if f.nan {
+		return []byte("null"), nil
+	}
+
+	return []byte(f.String()), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (f *Fixed) UnmarshalJSON(b []byte) error {
+	b = bytes.TrimSpace(b)
+
+	if isQuoted(b) {
+		b = b[1 : len(b)-1]
+	}
+
+	if bytes.Equal(b, []byte("null")) {
+		*f = Fixed{nan: true}
+		return nil
+	}
+
+	parsed, err := ParseFixed(string(b))
+	if err != nil {
+		return err
+	}
+
+	*f = parsed
+	return nil
+}
+
+// Value implements the sql.Valuer interface
+func (f Fixed) Value() (driver.Value, error) {
+	return f.String(), nil
+}
+
+// Scan implements the sql.Scanner interface
+func (f *Fixed) Scan(src interface{}) error {
+	switch src := src.(type) {
+	case string:
+		return f.UnmarshalJSON([]byte(src))
+	case []byte:
+		return f.UnmarshalJSON(src)
+	case nil:
+		*f = Fixed{nan: true}
+		return nil
+	default:
+		return errors.New(fmt.Sprint("Passed value ", src, " should be a string"))
+	}
+}
+
+func (f Fixed) coefficient() *big.Int {
+	if f.coef != nil {
+		return f.coef
+	}
+
+	return new(big.Int)
+}
+
+// alignFixed returns the coefficients of a and b at the larger of their scales.
+func alignFixed(a, b Fixed) (*big.Int, *big.Int, int32) {
+	scale := max(a.scale, b.scale)
+
+	return new(big.Int).Mul(a.coefficient(), pow10(int(scale)-int(a.scale))),
+		new(big.Int).Mul(b.coefficient(), pow10(int(scale)-int(b.scale))),
+		scale
+}
@@ -0,0 +1,258 @@
+package big
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewFixed(t *testing.T) {
+	assert.EqualValues(t, "12.30", NewFixed(1230, 2).String())
+	assert.EqualValues(t, "-0.005", NewFixed(-5, 3).String())
+	assert.EqualValues(t, "12000", NewFixed(12, -3).String())
+	assert.EqualValues(t, "0", Fixed{}.String())
+	assert.EqualValues(t, "0.00", NewFixed(0, 2).String())
+	assert.True(t, NewFixedFromBigInt(nil, 2).NaN())
+}
+
+func TestParseFixed(t *testing.T) {
+	examples := []struct {
+		input    string
+		expected string
+		scale    int32
+	}{
+		{"12.30", "12.30", 2},
+		{"-.5", "-0.5", 1},
+		{"+7", "7", 0},
+		{"1.5e3", "1500", -2},
+		{"1.5E-3", "0.0015", 4},
+		{"123456789012345678901234567890.123456789", "123456789012345678901234567890.123456789", 9},
+	}
+
+	for _, ex := range examples {
+		f, err := ParseFixed(ex.input)
+
+		if assert.NoError(t, err, ex.input) {
+			assert.EqualValues(t, ex.expected, f.String(), ex.input)
+			assert.EqualValues(t, ex.scale, f.Scale(), ex.input)
+		}
+	}
+
+	f, err := ParseFixed("NaN")
+	assert.NoError(t, err)
+	assert.True(t, f.NaN())
+
+	t.Run("errors", func(t *testing.T) {
+		examples := []struct {
+			input  string
+			err    error
+			offset int
+		}{
+			{"1.2x", ErrSyntax, 3},
+			{"", ErrSyntax, 0},
+			{"3p-2", ErrSyntax, 1},
+			{"-Inf", ErrRange, 0},
+			{"1e9999999999", ErrRange, 1},
+			{"1.5e-2147483647", ErrRange, 3},
+		}
+
+		for _, ex := range examples {
+			f, err := ParseFixed(ex.input)
+
+			var parseErr *ParseError
+			if assert.True(t, errors.As(err, &parseErr), ex.input) {
+				assert.True(t, errors.Is(err, ex.err), ex.input)
+				assert.EqualValues(t, ex.offset, parseErr.Offset, ex.input)
+			}
+			assert.True(t, f.NaN(), ex.input)
+			assert.True(t, NewFixedFromString(ex.input).NaN(), ex.input)
+		}
+	})
+}
+
+func TestFixed_Decimal(t *testing.T) {
+	for _, s := range []string{"0.1", "-12.345", "1e-20", "123456789012345678901234567890"} {
+		d := NewFromString(s)
+		f := NewFixedFromDecimal(d)
+
+		assert.True(t, f.Decimal().EQ(d), s)
+		assert.True(t, f.EQ(NewFixedFromString(s)), s)
+	}
+
+	assert.EqualValues(t, 0, NewFixedFromDecimal(NewFromString("1e3")).Scale())
+	assert.EqualValues(t, "1000", NewFixedFromDecimal(NewFromString("1e3")).String())
+	assert.True(t, NewFixedFromDecimal(NaN).NaN())
+	assert.True(t, NewFixedFromDecimal(PosInf()).NaN())
+	assert.True(t, FixedNaN.Decimal().NaN())
+}
+
+func TestFixed_Add(t *testing.T) {
+	assert.EqualValues(t, "0.3", NewFixedFromString("0.1").Add(NewFixedFromString("0.2")).String())
+	assert.EqualValues(t, "13.305", NewFixedFromString("12.30").Add(NewFixedFromString("1.005")).String())
+	assert.EqualValues(t, "10.50", Fixed{}.Add(NewFixed(1050, 2)).String())
+	assert.True(t, FixedNaN.Add(NewFixed(1, 0)).NaN())
+}
+
+func TestFixed_Sub(t *testing.T) {
+	assert.EqualValues(t, "-0.10", NewFixedFromString("0.20").Sub(NewFixedFromString("0.3")).String())
+	assert.True(t, NewFixed(1, 0).Sub(FixedNaN).NaN())
+}
+
+func TestFixed_Mul(t *testing.T) {
+	assert.EqualValues(t, "1.5000", NewFixedFromString("1.25").Mul(NewFixedFromString("1.20")).String())
+	assert.EqualValues(t, "-3000", NewFixedFromString("-1.5e3").Mul(NewFixed(2, 0)).String())
+	assert.True(t, NewFixed(1, 2147483647).Mul(NewFixed(1, 1)).NaN())
+	assert.True(t, FixedNaN.Mul(NewFixed(1, 0)).NaN())
+}
+
+func TestFixed_Div(t *testing.T) {
+	examples := []struct {
+		dividend, divisor string
+		scale             int32
+		mode              RoundingMode
+		expected          string
+	}{
+		{"1", "3", 4, RoundHalfEven, "0.3333"},
+		{"2", "3", 2, RoundDown, "0.66"},
+		{"-2", "3", 2, RoundHalfAwayFromZero, "-0.67"},
+		{"10.00", "-4", 1, RoundHalfEven, "-2.5"},
+		{"10.00", "-4", 0, RoundHalfEven, "-2"},
+		{"1.25", "0.5", 3, RoundHalfEven, "2.500"},
+		{"12345", "0.001", -3, RoundHalfEven, "12345000"},
+	}
+
+	for _, ex := range examples {
+		quo := NewFixedFromString(ex.dividend).Div(NewFixedFromString(ex.divisor), ex.scale, ex.mode)
+
+		assert.EqualValues(t, ex.expected, quo.String(), "%s / %s", ex.dividend, ex.divisor)
+		assert.EqualValues(t, ex.scale, quo.Scale())
+	}
+
+	assert.True(t, NewFixed(1, 0).Div(NewFixed(0, 2), 2, RoundHalfEven).NaN())
+	assert.True(t, FixedNaN.Div(NewFixed(1, 0), 2, RoundHalfEven).NaN())
+}
+
+func TestFixed_Rescale(t *testing.T) {
+	assert.EqualValues(t, "2.68", NewFixedFromString("2.675").Rescale(2, RoundHalfAwayFromZero).String())
+	assert.EqualValues(t, "2.67", NewFixedFromString("2.675").Rescale(2, RoundDown).String())
+	assert.EqualValues(t, "2.6750", NewFixedFromString("2.675").Rescale(4, RoundDown).String())
+	assert.EqualValues(t, "1200", NewFixedFromString("1234").Rescale(-2, RoundHalfEven).String())
+	assert.True(t, FixedNaN.Rescale(2, RoundHalfEven).NaN())
+}
+
+func TestFixed_NegAbsSign(t *testing.T) {
+	assert.EqualValues(t, "-1.50", NewFixed(150, 2).Neg().String())
+	assert.EqualValues(t, "1.50", NewFixed(-150, 2).Abs().String())
+	assert.EqualValues(t, -1, NewFixed(-150, 2).Sign())
+	assert.EqualValues(t, 0, Fixed{}.Sign())
+	assert.True(t, FixedNaN.Neg().NaN())
+	assert.EqualValues(t, "150", NewFixed(150, 2).Coefficient().String())
+	assert.Nil(t, FixedNaN.Coefficient())
+}
+
+func TestFixed_Comparisons(t *testing.T) {
+	a, b := NewFixedFromString("1.50"), NewFixedFromString("1.5")
+	c := NewFixedFromString("2")
+
+	validateBoolExamples(t,
+		booleanExample{a.EQ(b), true},
+		booleanExample{a.LT(c), true},
+		booleanExample{a.LTE(b), true},
+		booleanExample{c.GT(a), true},
+		booleanExample{c.GTE(c), true},
+		booleanExample{a.GT(c), false},
+		booleanExample{a.EQ(FixedNaN), false},
+		booleanExample{FixedNaN.EQ(FixedNaN), false},
+		booleanExample{FixedNaN.LT(a), false},
+		booleanExample{Fixed{}.IsZero(), true},
+		booleanExample{FixedNaN.IsZero(), false},
+	)
+
+	assert.EqualValues(t, 0, a.Cmp(b))
+	assert.EqualValues(t, -1, FixedNaN.Cmp(a))
+	assert.EqualValues(t, 1, a.Cmp(FixedNaN))
+	assert.EqualValues(t, 0, FixedNaN.Cmp(FixedNaN))
+	assert.EqualValues(t, 1.5, a.Float())
+}
+
+func TestFixed_Json(t *testing.T) {
+	type jsonType struct {
+		Fixed Fixed `json:"fixed"`
+	}
+
+	marshaled, err := json.Marshal(jsonType{Fixed: NewFixedFromString("12.30")})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"fixed":12.30}`, string(marshaled))
+
+	marshaled, err = json.Marshal(jsonType{Fixed: FixedNaN})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"fixed":null}`, string(marshaled))
+
+	t.Run("quoted", func(t *testing.T) {
+		oldMarshalQuoted := MarshalQuoted
+		t.Cleanup(func() {
+			MarshalQuoted = oldMarshalQuoted
+		})
+
+		MarshalQuoted = true
+		marshaled, err := json.Marshal(jsonType{Fixed: NewFixedFromString("12.30")})
+
+		assert.NoError(t, err)
+		assert.Equal(t, `{"fixed":"12.30"}`, string(marshaled))
+	})
+
+	for _, input := range []string{`{"fixed":12.30}`, `{"fixed":"12.30"}`} {
+		var ts jsonType
+
+		assert.NoError(t, json.Unmarshal([]byte(input), &ts))
+		assert.EqualValues(t, "12.30", ts.Fixed.String())
+	}
+
+	var ts jsonType
+	assert.NoError(t, json.Unmarshal([]byte(`{"fixed":null}`), &ts))
+	assert.True(t, ts.Fixed.NaN())
+	assert.True(t, errors.Is(json.Unmarshal([]byte(`{"fixed":"1.2.3"}`), &ts), ErrSyntax))
+}
+
+func TestFixed_Sql(t *testing.T) {
+	value, err := NewFixedFromString("12.30").Value()
+	assert.NoError(t, err)
+	assert.EqualValues(t, "12.30", value)
+
+	var f Fixed
+	assert.NoError(t, f.Scan("1.230"))
+	assert.EqualValues(t, "1.230", f.String())
+
+	assert.NoError(t, f.Scan([]byte("-4.5")))
+	assert.EqualValues(t, "-4.5", f.String())
+
+	assert.NoError(t, f.Scan(nil))
+	assert.True(t, f.NaN())
+
+	err = f.Scan(1.23)
+	assert.EqualValues(t, "Passed value 1.23 should be a string", err.Error())
+}
+
+func TestExportedFixedSentinelDoesNotAffectInternalMath(t *testing.T) {
+	oldNaN := FixedNaN
+	t.Cleanup(func() {
+		FixedNaN = oldNaN
+	})
+
+	FixedNaN = NewFixed(1, 0)
+
+	assert.True(t, NewFixed(1, 0).Div(NewFixed(0, 0), 2, RoundHalfEven).NaN())
+	assert.True(t, NewFixed(1, 0).Add(oldNaN).NaN())
+	assert.True(t, NewFixed(1, 0).Mul(oldNaN).NaN())
+	assert.True(t, NewFixedFromString("1.2.3").NaN())
+	assert.True(t, NewFixedFromDecimal(NaN).NaN())
+
+	var f Fixed
+	assert.NoError(t, f.Scan(nil))
+	assert.True(t, f.NaN())
+
+	assert.NoError(t, json.Unmarshal([]byte("null"), &f))
+	assert.True(t, f.NaN())
+}
@@ -0,0 +1,197 @@
+package big
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// Format implements the fmt.Formatter interface. The verbs 'e', 'E', 'f', 'F', 'g' and
+// 'G' and the flags '+', '-', ' ', '0' and width and precision behave as they do for
+// float64 values, but at the full precision of the Decimal.
+//
+// %v and %s print the result of String, or use %g when a precision is given, and %#v
+// prints a Go expression that recreates the Decimal, e.g. big.NewFromString("1.5"). As
+// for float64, the '+' flag does not add a sign to %v, since fmt sets it for %+v to
+// print struct field names.
+func (d Decimal) Format(s fmt.State, verb rune) {
+	switch verb {
+	case 'v', 's':
+		if verb == 'v' && s.Flag('#') {
+			fmt.Fprintf(s, "big.NewFromString(%q)", d.String())
+			return
+		} else if verb == 'v' {
+			s = unsignedState{s}
+		}
+
+		if _, hasPrec := s.Precision(); hasPrec && !d.NaN() {
+			d.value().Format(s, 'g')
+			return
+		}
+
+		d.pad(s, d.String())
+	case 'e', 'E', 'f', 'F', 'g', 'G':
+		if d.NaN() {
+			d.pad(s, "NaN")
+			return
+		}
+
+		d.value().Format(s, verb)
+	default:
+		fmt.Fprintf(s, "%%!%c(big.Decimal=%s)", verb, d.String())
+	}
+}
+
+// pad writes text to s, adding the sign requested by the '+' and ' ' flags and padding
+// it to the requested width. Only finite values are padded with zeros.
+func (d Decimal) pad(s fmt.State, text string) {
+	var sign string
+	switch {
+	case strings.HasPrefix(text, "-") || strings.HasPrefix(text, "+"):
+		sign, text = text[:1], text[1:]
+	case s.Flag('+'):
+		sign = "+"
+	case s.Flag(' '):
+		sign = " "
+	}
+
+	width, _ := s.Width()
+	padding := width - len(sign) - len(text)
+
+	switch {
+	case padding <= 0:
+		fmt.Fprint(s, sign, text)
+	case s.Flag('-'):
+		fmt.Fprint(s, sign, text, strings.Repeat(" ", padding))
+	case s.Flag('0') && d.finite():
+		fmt.Fprint(s, sign, strings.Repeat("0", padding), text)
+	default:
+		fmt.Fprint(s, strings.Repeat(" ", padding), sign, text)
+	}
+}
+
+// unsignedState is a fmt.State that hides the '+' flag.
+type unsignedState struct {
+	fmt.State
+}
+
+func (s unsignedState) Flag(c int) bool {
+	return c != '+' && s.State.Flag(c)
+}
+
+// NegativeStyle controls how FormatWith writes negative numbers.
+type NegativeStyle int
+
+const (
+	// NegativeMinus writes negative numbers with a leading minus sign, e.g. -1,234.50.
+	NegativeMinus NegativeStyle = iota
+	// NegativeParentheses writes negative numbers in parentheses, e.g. (1,234.50).
+	NegativeParentheses
+)
+
+// FormatOptions describes how FormatWith writes a Decimal for display.
+type FormatOptions struct {
+	// Places is the number of digits after the decimal separator. The value is rounded
+	// half away from zero, as by Round. A negative value writes every digit of the
+	// shortest decimal representation, as String does.
+	Places int
+	// GroupSeparator is written between groups of integer digits.
+	GroupSeparator string
+	// DecimalSeparator is written between the integer and fractional digits. The zero
+	// value writes ".".
+	DecimalSeparator string
+	// GroupSize is the number of integer digits in the group closest to the decimal
+	// separator. Zero disables grouping.
+	GroupSize int
+	// SecondaryGroupSize is the number of digits in every other group, such as 2 for the
+	// lakh grouping of 12,34,567. Zero uses GroupSize.
+	SecondaryGroupSize int
+	// NegativeStyle controls how negative numbers are written.
+	NegativeStyle NegativeStyle
+	// PlusSign writes a leading plus sign on positive numbers.
+	PlusSign bool
+}
+
+// Presets for common locales, which write two decimal places.
+var (
+	// FormatEnUS writes 1,234,567.89
+	FormatEnUS = FormatOptions{Places: 2, GroupSeparator: ",", DecimalSeparator: ".", GroupSize: 3}
+	// FormatDeDE writes 1.234.567,89
+	FormatDeDE = FormatOptions{Places: 2, GroupSeparator: ".", DecimalSeparator: ",", GroupSize: 3}
+	// FormatFrFR writes 1 234 567,89, grouped with narrow no-break spaces
+	FormatFrFR = FormatOptions{Places: 2, GroupSeparator: "\u202f", DecimalSeparator: ",", GroupSize: 3}
+	// FormatEnIN writes 12,34,567.89
+	FormatEnIN = FormatOptions{Places: 2, GroupSeparator: ",", DecimalSeparator: ".", GroupSize: 3, SecondaryGroupSize: 2}
+	// FormatChCH writes 1’234’567.89
+	FormatChCH = FormatOptions{Places: 2, GroupSeparator: "\u2019", DecimalSeparator: ".", GroupSize: 3}
+)
+
+// FormatWith returns this Decimal written for display according to opts, without
+// converting it to a float64. NaN is written as "NaN" and the infinities as "Inf" with
+// the sign opts calls for.
+func (d Decimal) FormatWith(opts FormatOptions) string {
+	if d.NaN() {
+		return "NaN"
+	}
+
+	text, negative := "Inf", d.Sign() < 0
+	if d.finite() {
+		rounded := d
+		if opts.Places >= 0 {
+			rounded = d.Round(opts.Places)
+		}
+
+		coef, exp := rounded.decimalParts()
+		integer, fraction, _ := strings.Cut(scaledText(new(big.Int).Abs(coef), -exp), ".")
+
+		if opts.Places >= 0 {
+			fraction += strings.Repeat("0", opts.Places-len(fraction))
+		}
+
+		text = groupDigits(integer, opts)
+		if fraction != "" {
+			separator := opts.DecimalSeparator
+			if separator == "" {
+				separator = "."
+			}
+
+			text += separator + fraction
+		}
+
+		negative = coef.Sign() < 0
+	}
+
+	switch {
+	case negative && opts.NegativeStyle == NegativeParentheses:
+		return "(" + text + ")"
+	case negative:
+		return "-" + text
+	case opts.PlusSign:
+		return "+" + text
+	default:
+		return text
+	}
+}
+
+// groupDigits inserts the group separator of opts between groups of integer digits.
+func groupDigits(digits string, opts FormatOptions) string {
+	if opts.GroupSize <= 0 || len(digits) <= opts.GroupSize {
+		return digits
+	}
+
+	size := opts.SecondaryGroupSize
+	if size <= 0 {
+		size = opts.GroupSize
+	}
+
+	end := len(digits) - opts.GroupSize
+	groups := []string{digits[end:]}
+
+	for end > 0 {
+		start := max(end-size, 0)
+		groups = append([]string{digits[start:end]}, groups...)
+		end = start
+	}
+
+	return strings.Join(groups, opts.GroupSeparator)
+}
@@ -0,0 +1,105 @@
+package big
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecimal_Format(t *testing.T) {
+	examples := []struct {
+		format   string
+		value    Decimal
+		expected string
+	}{
+		{"%v", NewFromString("1.5"), "1.5"},
+		{"%s", NewFromString("-1.5"), "-1.5"},
+		{"%+v", NewFromString("1.5"), "1.5"},
+		{"%+.2v", NewFromString("1.5"), "1.5"},
+		{"%+s", NewFromString("1.5"), "+1.5"},
+		{"% v", NewFromString("1.5"), " 1.5"},
+		{"%6v", NewFromString("1.5"), "   1.5"},
+		{"%-6v|", NewFromString("1.5"), "1.5   |"},
+		{"%06v", NewFromString("-1.5"), "-001.5"},
+		{"%.3v", NewFromString("3.14159"), "3.14"},
+		{"%f", NewFromString("1.5"), "1.500000"},
+		{"%.4f", NewFromString("2.71828"), "2.7183"},
+		{"%.2F", NewFromString("-0.0051"), "-0.01"},
+		{"%+08.2f", NewFromString("1.5"), "+0001.50"},
+		{"%e", NewFromString("123456"), "1.234560e+05"},
+		{"%.2E", NewFromString("0.000123"), "1.23E-04"},
+		{"%g", NewFromString("0.0001"), "0.0001"},
+		{"%G", NewFromString("1e-20"), "1E-20"},
+		{"%.30f", NewFromString("1").Div(NewFromString("3")), "0.333333333333333333333333333333"},
+		{"%f", PosInf(), "+Inf"},
+		{"%8f", NegInf(), "    -Inf"},
+		{"%08v", NegInf(), "    -Inf"},
+		{"%f", NaN, "NaN"},
+		{"%+f", NaN, "+NaN"},
+		{"%05g", NaN, "  NaN"},
+		{"%-5v|", NaN, "NaN  |"},
+		{"%#v", NewFromString("-1.5"), `big.NewFromString("-1.5")`},
+		{"%#v", NaN, `big.NewFromString("NaN")`},
+		{"%d", NewFromString("1.5"), "%!d(big.Decimal=1.5)"},
+	}
+
+	for _, ex := range examples {
+		assert.EqualValues(t, ex.expected, fmt.Sprintf(ex.format, ex.value), ex.format)
+	}
+}
+
+func TestDecimal_Format_MatchesFloat64(t *testing.T) {
+	formats := []string{"%v", "%+v", "%+.3v", "%f", "%.3f", "%e", "%+.2e", "%g", "%G", "%10.4f", "%-10.1f|", "%010.3f", "% f"}
+
+	for _, value := range []float64{0, 1.25, -1.25, 1234.5, -0.0009765625} {
+		for _, format := range formats {
+			assert.EqualValues(t, fmt.Sprintf(format, value), fmt.Sprintf(format, NewDecimal(value)), "%s %v", format, value)
+		}
+	}
+}
+
+func TestDecimal_Format_StructField(t *testing.T) {
+	value := struct {
+		D Decimal
+		F float64
+	}{NewFromString("1.5"), 1.5}
+
+	assert.EqualValues(t, "{D:1.5 F:1.5}", fmt.Sprintf("%+v", value))
+	assert.EqualValues(t, "{1.5 1.5}", fmt.Sprintf("%v", value))
+}
+
+func TestDecimal_FormatWith(t *testing.T) {
+	value := NewFromString("1234567.891")
+
+	examples := []struct {
+		opts     FormatOptions
+		value    Decimal
+		expected string
+	}{
+		{FormatEnUS, value, "1,234,567.89"},
+		{FormatDeDE, value, "1.234.567,89"},
+		{FormatFrFR, value, "1\u202f234\u202f567,89"},
+		{FormatEnIN, value, "12,34,567.89"},
+		{FormatEnIN, NewFromString("123456789"), "12,34,56,789.00"},
+		{FormatChCH, value, "1’234’567.89"},
+		{FormatEnUS, NewFromString("-0.005"), "-0.01"},
+		{FormatEnUS, NewFromString("-0.004"), "0.00"},
+		{FormatEnUS, NewFromString("999.995"), "1,000.00"},
+		{FormatEnUS, NewFromString("123"), "123.00"},
+		{FormatOptions{Places: 0, GroupSeparator: ",", GroupSize: 3}, value, "1,234,568"},
+		{FormatOptions{Places: -1, GroupSeparator: ",", GroupSize: 3}, NewFromString("12345.678901234567890123"), "12,345.678901234567890123"},
+		{FormatOptions{Places: -1}, NewFromString("1e25"), "10000000000000000000000000"},
+		{FormatOptions{Places: 2, NegativeStyle: NegativeParentheses}, NewFromString("-42.5"), "(42.50)"},
+		{FormatOptions{Places: 2, PlusSign: true}, NewFromString("42.5"), "+42.50"},
+		{FormatOptions{Places: 1, PlusSign: true}, ZERO, "+0.0"},
+		{FormatOptions{Places: 2, DecimalSeparator: ","}, NewFromString("0.5"), "0,50"},
+		{FormatOptions{NegativeStyle: NegativeParentheses}, NegInf(), "(Inf)"},
+		{FormatOptions{PlusSign: true}, PosInf(), "+Inf"},
+		{FormatEnUS, NaN, "NaN"},
+	}
+
+	for _, ex := range examples {
+		assert.EqualValues(t, ex.expected, ex.value.FormatWith(ex.opts), "%+v %s", ex.opts, ex.value)
+	}
+}
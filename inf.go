@@ -0,0 +1,66 @@
+package big
+
+import (
+	"errors"
+	"math/big"
+)
+
+// ErrIndeterminate is the reason recorded for a NaN produced by an indeterminate form:
+// the sum of opposite infinities, zero times infinity, or infinity divided by infinity.
+var ErrIndeterminate = errors.New("indeterminate form")
+
+// Inf returns positive infinity if sign >= 0, negative infinity if sign < 0.
+//
+// Infinities follow IEEE-754 rules. A finite value plus or minus an infinity, an infinity
+// times a non-zero value, and a non-zero value divided by zero are infinite, with the sign
+// given by the operands. A finite value divided by an infinity is zero. The indeterminate
+// forms Inf - Inf, 0 × Inf and Inf / Inf are NaN with reason ErrIndeterminate, and 0 / 0
+// is NaN with reason ErrDivisionByZero. Infinities compare equal to themselves and order
+// beyond every finite value. They print as "+Inf" and "-Inf", marshal to JSON as the
+// quoted strings "+Inf" and "-Inf", and are written to SQL as "Infinity" and "-Infinity".
+func Inf(sign int) Decimal {
+	return Decimal{fl: newFloat(minPrecision).SetInf(sign < 0)}
+}
+
+// PosInf returns positive infinity.
+func PosInf() Decimal {
+	return Inf(1)
+}
+
+// NegInf returns negative infinity.
+func NegInf() Decimal {
+	return Inf(-1)
+}
+
+// IsInf reports whether this Decimal is an infinity, according to sign. If sign > 0,
+// IsInf reports whether it is positive infinity. If sign < 0, IsInf reports whether it is
+// negative infinity. If sign == 0, IsInf reports whether it is either infinity.
+func (d Decimal) IsInf(sign int) bool {
+	if d.NaN() || !d.value().IsInf() {
+		return false
+	}
+
+	return sign == 0 || (sign > 0) == (d.value().Sign() > 0)
+}
+
+// Sign returns -1 if this Decimal is negative, 0 if it is zero or NaN, and 1 if it is
+// positive.
+func (d Decimal) Sign() int {
+	if d.NaN() {
+		return 0
+	}
+
+	return d.value().Sign()
+}
+
+func (d Decimal) finite() bool {
+	return !d.NaN() && !d.value().IsInf()
+}
+
+func infText(fl *big.Float, positive, negative string) string {
+	if fl.Signbit() {
+		return negative
+	}
+
+	return positive
+}
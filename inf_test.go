@@ -0,0 +1,228 @@
+package big
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInf(t *testing.T) {
+	assert.True(t, Inf(1).IsInf(1))
+	assert.True(t, Inf(0).IsInf(1))
+	assert.True(t, Inf(-1).IsInf(-1))
+	assert.True(t, PosInf().EQ(Inf(1)))
+	assert.True(t, NegInf().EQ(Inf(-1)))
+	assert.True(t, NewDecimal(math.Inf(-1)).IsInf(-1))
+	assert.EqualValues(t, math.Inf(1), PosInf().Float())
+}
+
+func TestDecimal_IsInf(t *testing.T) {
+	validateBoolExamples(t,
+		booleanExample{
+			value:    PosInf().IsInf(0),
+			expected: true,
+		},
+		booleanExample{
+			value:    PosInf().IsInf(-1),
+			expected: false,
+		},
+		booleanExample{
+			value:    NegInf().IsInf(0),
+			expected: true,
+		},
+		booleanExample{
+			value:    NegInf().IsInf(1),
+			expected: false,
+		},
+		booleanExample{
+			value:    TEN.IsInf(0),
+			expected: false,
+		},
+		booleanExample{
+			value:    NaN.IsInf(0),
+			expected: false,
+		},
+	)
+}
+
+func TestDecimal_Sign(t *testing.T) {
+	assert.EqualValues(t, 1, TEN.Sign())
+	assert.EqualValues(t, -1, TEN.Neg().Sign())
+	assert.EqualValues(t, 0, ZERO.Sign())
+	assert.EqualValues(t, 0, NaN.Sign())
+	assert.EqualValues(t, 1, PosInf().Sign())
+	assert.EqualValues(t, -1, NegInf().Sign())
+}
+
+func TestInfArithmetic(t *testing.T) {
+	validateEqExamples(t,
+		equalExample{
+			value:    PosInf().Add(TEN),
+			expected: "+Inf",
+		},
+		equalExample{
+			value:    NegInf().Sub(TEN),
+			expected: "-Inf",
+		},
+		equalExample{
+			value:    PosInf().Add(PosInf()),
+			expected: "+Inf",
+		},
+		equalExample{
+			value:    PosInf().Mul(TEN.Neg()),
+			expected: "-Inf",
+		},
+		equalExample{
+			value:    TEN.Div(ZERO),
+			expected: "+Inf",
+		},
+		equalExample{
+			value:    TEN.Neg().Div(ZERO),
+			expected: "-Inf",
+		},
+		equalExample{
+			value:    TEN.Div(PosInf()),
+			expected: "0",
+		},
+		equalExample{
+			value:    NegInf().Div(TEN),
+			expected: "-Inf",
+		},
+		equalExample{
+			value:    NegInf().Abs(),
+			expected: "+Inf",
+		},
+		equalExample{
+			value:    NegInf().Neg(),
+			expected: "+Inf",
+		},
+		equalExample{
+			value:    PosInf().Sqrt(),
+			expected: "+Inf",
+		},
+		equalExample{
+			value:    NegInf().Pow(3),
+			expected: "-Inf",
+		},
+		equalExample{
+			value:    NegInf().Pow(-3),
+			expected: "-0",
+		},
+		equalExample{
+			value:    ZERO.Pow(-2),
+			expected: "+Inf",
+		},
+		equalExample{
+			value:    MaxSlice(ONE, PosInf()),
+			expected: "+Inf",
+		},
+		equalExample{
+			value:    MinSlice(ONE, NegInf()),
+			expected: "-Inf",
+		},
+		equalExample{
+			value:    PosInf().Round(2),
+			expected: "+Inf",
+		},
+	)
+
+	t.Run("indeterminate forms", func(t *testing.T) {
+		for _, d := range []Decimal{
+			PosInf().Add(NegInf()),
+			PosInf().Sub(PosInf()),
+			ZERO.Mul(NegInf()),
+			PosInf().Mul(ZERO),
+			PosInf().Div(NegInf()),
+		} {
+			assert.True(t, d.NaN())
+			assert.True(t, errors.Is(d.NaNReason(), ErrIndeterminate))
+		}
+
+		assert.True(t, errors.Is(ZERO.Div(ZERO).NaNReason(), ErrDivisionByZero))
+		assert.True(t, errors.Is(NegInf().Sqrt().NaNReason(), ErrDomain))
+	})
+
+	t.Run("never panics", func(t *testing.T) {
+		values := []Decimal{PosInf(), NegInf(), ZERO, ZERO.Neg(), ONE, TEN.Neg(), NaN, {}}
+
+		for _, x := range values {
+			for _, y := range values {
+				assert.NotPanics(t, func() {
+					x.Add(y)
+					x.Sub(y)
+					x.Mul(y)
+					x.Div(y)
+					x.Cmp(y)
+				}, "%s, %s", x, y)
+			}
+
+			assert.NotPanics(t, func() {
+				x.Sqrt()
+				x.Pow(-3)
+				x.Pow(2)
+				x.Abs()
+				x.Neg()
+				x.Frac(math.Inf(1))
+				x.Round(2)
+				x.Float()
+				x.FormattedString(2)
+			}, "%s", x)
+		}
+	})
+}
+
+func TestInfComparisons(t *testing.T) {
+	assert.True(t, PosInf().EQ(PosInf()))
+	assert.True(t, NegInf().LT(NewFromString("-1e1000")))
+	assert.True(t, PosInf().GT(NewFromString("1e1000")))
+	assert.True(t, NegInf().LT(PosInf()))
+	assert.False(t, PosInf().EQ(NaN))
+}
+
+func TestInfEncoding(t *testing.T) {
+	t.Run("String", func(t *testing.T) {
+		assert.EqualValues(t, "+Inf", PosInf().String())
+		assert.EqualValues(t, "-Inf", NegInf().String())
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		marshaled, err := json.Marshal([]Decimal{PosInf(), NegInf()})
+
+		assert.NoError(t, err)
+		assert.EqualValues(t, `["+Inf","-Inf"]`, string(marshaled))
+
+		var unmarshaled []Decimal
+		assert.NoError(t, json.Unmarshal(marshaled, &unmarshaled))
+		assert.True(t, unmarshaled[0].IsInf(1))
+		assert.True(t, unmarshaled[1].IsInf(-1))
+	})
+
+	t.Run("SQL", func(t *testing.T) {
+		value, err := NegInf().Value()
+
+		assert.NoError(t, err)
+		assert.EqualValues(t, "-Infinity", value)
+
+		var d Decimal
+		assert.NoError(t, d.Scan("Infinity"))
+		assert.True(t, d.IsInf(1))
+		assert.NoError(t, d.Scan([]byte("-infinity")))
+		assert.True(t, d.IsInf(-1))
+	})
+
+	t.Run("Parse", func(t *testing.T) {
+		for _, input := range []string{"Inf", "+inf", "INF", "Infinity", "+INFINITY"} {
+			d, err := Parse(input)
+
+			assert.NoError(t, err)
+			assert.True(t, d.IsInf(1), input)
+		}
+
+		d, err := Parse("-Infinity")
+		assert.NoError(t, err)
+		assert.True(t, d.IsInf(-1))
+	})
+}
@@ -0,0 +1,269 @@
+package big
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrCurrencyMismatch indicates an operation on amounts of two different currencies.
+var ErrCurrencyMismatch = errors.New("currency mismatch")
+
+// Money is an immutable amount of an ISO 4217 currency.
+//
+// Operations on two amounts of different currencies return an error matching
+// ErrCurrencyMismatch. Amounts are not rounded until Round is called, so intermediate
+// results such as interest keep their full precision. The zero value has no currency and
+// cannot be serialized; create a Money with NewMoney.
+type Money struct {
+	amount   Decimal
+	currency Currency
+}
+
+// NewMoney creates a new Money with the given amount of the currency with the given ISO
+// 4217 code, in any case. It returns an error matching ErrUnknownCurrency if there is no
+// such currency.
+func NewMoney(amount Decimal, code string) (Money, error) {
+	currency, err := LookupCurrency(code)
+	if err != nil {
+		return Money{}, err
+	}
+
+	return Money{amount: amount, currency: currency}, nil
+}
+
+// Amount returns the amount of this Money.
+func (m Money) Amount() Decimal {
+	return m.amount
+}
+
+// Currency returns the currency of this Money.
+func (m Money) Currency() Currency {
+	return m.currency
+}
+
+// Add returns the sum of this Money and the addend, which must have the same currency.
+func (m Money) Add(addend Money) (Money, error) {
+	if err := m.checkCurrency(addend); err != nil {
+		return Money{}, err
+	}
+
+	return Money{amount: m.amount.Add(addend.amount), currency: m.currency}, nil
+}
+
+// Sub returns the difference of this Money and the subtrahend, which must have the same
+// currency.
+func (m Money) Sub(subtrahend Money) (Money, error) {
+	if err := m.checkCurrency(subtrahend); err != nil {
+		return Money{}, err
+	}
+
+	return Money{amount: m.amount.Sub(subtrahend.amount), currency: m.currency}, nil
+}
+
+// Mul returns this Money multiplied by the factor.
+func (m Money) Mul(factor Decimal) Money {
+	return Money{amount: m.amount.Mul(factor), currency: m.currency}
+}
+
+// Neg returns this Money negated.
+func (m Money) Neg() Money {
+	return Money{amount: m.amount.Neg(), currency: m.currency}
+}
+
+// Abs returns the absolute value of this Money.
+func (m Money) Abs() Money {
+	return Money{amount: m.amount.Abs(), currency: m.currency}
+}
+
+// Cmp compares this Money with other, which must have the same currency, returning 1 if
+// this Money is greater, 0 if they are the same, and -1 if it is less.
+func (m Money) Cmp(other Money) (int, error) {
+	if err := m.checkCurrency(other); err != nil {
+		return 0, err
+	}
+
+	return m.amount.Cmp(other.amount), nil
+}
+
+// IsZero will return true if the amount of this Money is 0.
+func (m Money) IsZero() bool {
+	return m.amount.IsZero()
+}
+
+// Sign returns -1, 0 or 1 when the amount of this Money is negative, zero or positive.
+func (m Money) Sign() int {
+	return m.amount.Sign()
+}
+
+// Round returns this Money rounded to the minor unit of its currency, with ties rounded
+// away from zero.
+func (m Money) Round() Money {
+	return m.RoundMode(RoundHalfAwayFromZero)
+}
+
+// RoundMode returns this Money rounded to the minor unit of its currency using the given
+// rounding mode.
+func (m Money) RoundMode(mode RoundingMode) Money {
+	return Money{amount: m.amount.RoundMode(m.currency.MinorUnits, mode), currency: m.currency}
+}
+
+// String returns the amount of this Money rounded to the minor unit of its currency,
+// followed by its currency code, e.g. "12.30 USD".
+func (m Money) String() string {
+	return m.amount.FormatWith(FormatOptions{Places: m.currency.MinorUnits}) + " " + m.currency.Code
+}
+
+// MarshalJSON implements the json.Marshaler interface. Money is written as an object with
+// the amount as a string, e.g. {"amount":"12.30","currency":"USD"}. The amount is written
+// with at least as many decimal places as the currency's minor unit, and is not rounded.
+// A Money with no currency returns an error matching ErrUnknownCurrency.
+func (m Money) MarshalJSON() ([]byte, error) {
+	if err := m.checkSerializable(); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(moneyJSON{Amount: m.amountText(), Currency: m.currency.Code})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. The amount may be a string or a
+// number, and must be present. Like the standard decoders, it leaves the Money unchanged
+// for null.
+func (m *Money) UnmarshalJSON(b []byte) error {
+	if bytes.Equal(bytes.TrimSpace(b), []byte("null")) {
+		return nil
+	}
+
+	var raw struct {
+		Amount   *Decimal `json:"amount"`
+		Currency string   `json:"currency"`
+	}
+
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	} else if raw.Amount == nil {
+		return fmt.Errorf("big: unmarshaling %s: money has no amount", b)
+	}
+
+	parsed, err := NewMoney(*raw.Amount, raw.Currency)
+	if err != nil {
+		return err
+	}
+
+	*m = parsed
+	return nil
+}
+
+// Value implements the sql.Valuer interface. Money is written as the text of a composite
+// value of the amount and the currency code, e.g. (12.30,USD). A Money with no currency
+// returns an error matching ErrUnknownCurrency.
+func (m Money) Value() (driver.Value, error) {
+	if err := m.checkSerializable(); err != nil {
+		return nil, err
+	}
+
+	return "(" + m.amountText() + "," + m.currency.Code + ")", nil
+}
+
+// Scan implements the sql.Scanner interface. A SQL NULL scans as the zero Money, which
+// has no currency.
+func (m *Money) Scan(src interface{}) error {
+	var text []byte
+	switch src := src.(type) {
+	case string:
+		text = []byte(src)
+	case []byte:
+		text = src
+	case nil:
+		*m = Money{}
+		return nil
+	default:
+		return errors.New(fmt.Sprint("Passed value ", src, " should be a string"))
+	}
+
+	text = bytes.TrimSpace(text)
+	if len(text) < 2 || text[0] != '(' || text[len(text)-1] != ')' {
+		return fmt.Errorf("big: scanning %q: money must be a composite of an amount and a currency", text)
+	}
+
+	amount, code, found := strings.Cut(string(text[1:len(text)-1]), ",")
+	if !found {
+		return fmt.Errorf("big: scanning %q: money must be a composite of an amount and a currency", text)
+	}
+
+	var d Decimal
+	if err := d.Scan(strings.TrimSpace(amount)); err != nil {
+		return err
+	}
+
+	parsed, err := NewMoney(d, strings.Trim(strings.TrimSpace(code), `"`))
+	if err != nil {
+		return err
+	}
+
+	*m = parsed
+	return nil
+}
+
+// Allocate divides this Money into parts proportional to the given ratios, rounded to
+// the minor unit of its currency, such that the parts sum exactly to this Money rounded
+// to its minor unit. See Decimal.Allocate.
+func (m Money) Allocate(ratios ...Decimal) []Money {
+	return m.withAmounts(m.amount.Allocate(m.currency.MinorUnits, ratios...))
+}
+
+// Split divides this Money into n equal parts, rounded to the minor unit of its currency,
+// such that the parts sum exactly to this Money rounded to its minor unit. See
+// Decimal.Split.
+func (m Money) Split(n int) []Money {
+	return m.withAmounts(m.amount.Split(m.currency.MinorUnits, n))
+}
+
+type moneyJSON struct {
+	Amount   string `json:"amount"`
+	Currency string `json:"currency"`
+}
+
+// amountText returns the amount of this Money with at least as many decimal places as
+// the minor unit of its currency.
+func (m Money) amountText() string {
+	if !m.amount.finite() {
+		return m.amount.String()
+	}
+
+	return m.amount.FormatWith(FormatOptions{Places: max(m.amount.Scale(), m.currency.MinorUnits)})
+}
+
+func (m Money) checkCurrency(other Money) error {
+	if m.currency.Code != other.currency.Code {
+		return fmt.Errorf("big: %w: %s and %s", ErrCurrencyMismatch, m.currency.Code, other.currency.Code)
+	}
+
+	return nil
+}
+
+// checkSerializable returns an error if this Money has no currency, as the zero value
+// does, since it could not be read back.
+func (m Money) checkSerializable() error {
+	if m.currency.Code == "" {
+		return fmt.Errorf("big: serializing money: %w \"\"", ErrUnknownCurrency)
+	}
+
+	return nil
+}
+
+func (m Money) withAmounts(amounts []Decimal) []Money {
+	if amounts == nil {
+		return nil
+	}
+
+	parts := make([]Money, len(amounts))
+	for i, amount := range amounts {
+		parts[i] = Money{amount: amount, currency: m.currency}
+	}
+
+	return parts
+}
@@ -0,0 +1,181 @@
+package big
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func mustMoney(t *testing.T, amount, code string) Money {
+	t.Helper()
+
+	m, err := NewMoney(NewFromString(amount), code)
+	assert.NoError(t, err)
+
+	return m
+}
+
+func TestNewMoney(t *testing.T) {
+	m, err := NewMoney(NewFromString("12.3"), "usd")
+
+	assert.NoError(t, err)
+	assert.EqualValues(t, "USD", m.Currency().Code)
+	assert.True(t, m.Amount().EQ(NewFromString("12.3")))
+
+	_, err = NewMoney(ONE, "ABC")
+	assert.True(t, errors.Is(err, ErrUnknownCurrency))
+}
+
+func TestMoney_Add(t *testing.T) {
+	sum, err := mustMoney(t, "0.1", "USD").Add(mustMoney(t, "0.2", "USD"))
+
+	assert.NoError(t, err)
+	assert.EqualValues(t, "0.30 USD", sum.String())
+
+	_, err = mustMoney(t, "1", "USD").Add(mustMoney(t, "1", "EUR"))
+	assert.True(t, errors.Is(err, ErrCurrencyMismatch))
+	assert.EqualError(t, err, "big: currency mismatch: USD and EUR")
+}
+
+func TestMoney_Sub(t *testing.T) {
+	diff, err := mustMoney(t, "10", "JPY").Sub(mustMoney(t, "25", "JPY"))
+
+	assert.NoError(t, err)
+	assert.EqualValues(t, "-15 JPY", diff.String())
+
+	_, err = mustMoney(t, "1", "USD").Sub(Money{})
+	assert.True(t, errors.Is(err, ErrCurrencyMismatch))
+}
+
+func TestMoney_Mul(t *testing.T) {
+	interest := mustMoney(t, "1000", "USD").Mul(NewFromString("0.0375"))
+
+	assert.EqualValues(t, "37.50 USD", interest.String())
+	assert.True(t, interest.Round().Amount().EQ(NewFromString("37.5")))
+	assert.EqualValues(t, "USD", interest.Currency().Code)
+}
+
+func TestMoney_Cmp(t *testing.T) {
+	cmp, err := mustMoney(t, "1.50", "GBP").Cmp(mustMoney(t, "1.5", "GBP"))
+	assert.NoError(t, err)
+	assert.EqualValues(t, 0, cmp)
+
+	cmp, err = mustMoney(t, "-2", "GBP").Cmp(mustMoney(t, "1", "GBP"))
+	assert.NoError(t, err)
+	assert.EqualValues(t, -1, cmp)
+
+	_, err = mustMoney(t, "1", "GBP").Cmp(mustMoney(t, "1", "USD"))
+	assert.True(t, errors.Is(err, ErrCurrencyMismatch))
+}
+
+func TestMoney_Round(t *testing.T) {
+	examples := []struct {
+		amount, code string
+		mode         RoundingMode
+		expected     string
+	}{
+		{"12.345", "USD", RoundHalfAwayFromZero, "12.35"},
+		{"12.345", "USD", RoundHalfEven, "12.34"},
+		{"1234.5", "JPY", RoundHalfAwayFromZero, "1235"},
+		{"1.23456", "KWD", RoundDown, "1.234"},
+		{"-0.00005", "CLF", RoundHalfAwayFromZero, "-0.0001"},
+	}
+
+	for _, ex := range examples {
+		rounded := mustMoney(t, ex.amount, ex.code).RoundMode(ex.mode)
+
+		assert.EqualValues(t, ex.expected, rounded.Amount().String(), ex.amount)
+		assert.EqualValues(t, ex.code, rounded.Currency().Code)
+	}
+
+	assert.EqualValues(t, "12.35", mustMoney(t, "12.345", "USD").Round().Amount().String())
+}
+
+func TestMoney_Sign(t *testing.T) {
+	assert.EqualValues(t, -1, mustMoney(t, "-3", "USD").Sign())
+	assert.EqualValues(t, "3.00 USD", mustMoney(t, "-3", "USD").Abs().String())
+	assert.EqualValues(t, "3.00 USD", mustMoney(t, "-3", "USD").Neg().String())
+	assert.True(t, mustMoney(t, "0", "USD").IsZero())
+}
+
+func TestMoney_Json(t *testing.T) {
+	marshaled, err := json.Marshal(mustMoney(t, "12.3", "USD"))
+	assert.NoError(t, err)
+	assert.Equal(t, `{"amount":"12.30","currency":"USD"}`, string(marshaled))
+
+	marshaled, err = json.Marshal(mustMoney(t, "12.345", "USD"))
+	assert.NoError(t, err)
+	assert.Equal(t, `{"amount":"12.345","currency":"USD"}`, string(marshaled))
+
+	for _, input := range []string{`{"amount":"12.34","currency":"USD"}`, `{"amount":12.34,"currency":"usd"}`} {
+		var m Money
+
+		assert.NoError(t, json.Unmarshal([]byte(input), &m))
+		assert.EqualValues(t, "12.34 USD", m.String())
+	}
+
+	var m Money
+	assert.True(t, errors.Is(json.Unmarshal([]byte(`{"amount":"1","currency":"ABC"}`), &m), ErrUnknownCurrency))
+	assert.True(t, errors.Is(json.Unmarshal([]byte(`{"amount":"1x","currency":"USD"}`), &m), ErrSyntax))
+
+	t.Run("zero value", func(t *testing.T) {
+		_, err := json.Marshal(Money{})
+		assert.True(t, errors.Is(err, ErrUnknownCurrency))
+
+		marshaled, err := json.Marshal(mustMoney(t, "0", "USD"))
+		assert.NoError(t, err)
+
+		var m Money
+		assert.NoError(t, json.Unmarshal(marshaled, &m))
+		assert.EqualValues(t, "0.00 USD", m.String())
+	})
+
+	t.Run("null", func(t *testing.T) {
+		var row struct {
+			Balance Money `json:"balance"`
+		}
+
+		assert.NoError(t, json.Unmarshal([]byte(`{"balance":null}`), &row))
+		assert.Empty(t, row.Balance.Currency().Code)
+
+		row.Balance = mustMoney(t, "5", "USD")
+		assert.NoError(t, json.Unmarshal([]byte(`{"balance":null}`), &row))
+		assert.EqualValues(t, "5.00 USD", row.Balance.String())
+	})
+
+	t.Run("missing amount", func(t *testing.T) {
+		for _, input := range []string{`{"currency":"USD"}`, `{"amount":null,"currency":"USD"}`} {
+			var m Money
+			assert.EqualError(t, json.Unmarshal([]byte(input), &m), "big: unmarshaling "+input+": money has no amount")
+		}
+	})
+}
+
+func TestMoney_Sql(t *testing.T) {
+	value, err := mustMoney(t, "12.3", "EUR").Value()
+	assert.NoError(t, err)
+	assert.EqualValues(t, "(12.30,EUR)", value)
+
+	var m Money
+	assert.NoError(t, m.Scan("(12.34,USD)"))
+	assert.EqualValues(t, "12.34 USD", m.String())
+
+	assert.NoError(t, m.Scan([]byte(` (-5, "JPY") `)))
+	assert.EqualValues(t, "-5 JPY", m.String())
+
+	for _, input := range []string{"12.34 USD", "(12.34)", ""} {
+		assert.Error(t, m.Scan(input), input)
+	}
+
+	assert.True(t, errors.Is(m.Scan("(1,ABC)"), ErrUnknownCurrency))
+	assert.EqualError(t, m.Scan(1.23), "Passed value 1.23 should be a string")
+
+	m = mustMoney(t, "1", "USD")
+	assert.NoError(t, m.Scan(nil))
+	assert.EqualValues(t, Money{}, m)
+
+	_, err = Money{}.Value()
+	assert.True(t, errors.Is(err, ErrUnknownCurrency))
+}
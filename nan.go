@@ -0,0 +1,88 @@
+package big
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	// ErrDivisionByZero is the reason recorded for a NaN produced by dividing zero by zero.
+	ErrDivisionByZero = errors.New("division by zero")
+
+	// ErrDomain is the reason recorded for a NaN produced by applying a function to an
+	// argument outside its domain, such as the square root of a negative number.
+	ErrDomain = errors.New("argument out of domain")
+)
+
+// NaNError describes why a Decimal is NaN. Its Reason is ErrDivisionByZero, ErrDomain, or
+// the *ParseError of a string that could not be parsed.
+//
+// Op and Operands are only recorded when the package is built with the bigdebug build
+// tag, since keeping every operand alive is too costly for normal use.
+type NaNError struct {
+	Reason   error
+	Op       string
+	Operands []Decimal
+}
+
+func (e *NaNError) Error() string {
+	if e.Op == "" {
+		return "big: NaN: " + e.Reason.Error()
+	}
+
+	operands := make([]string, len(e.Operands))
+	for i, operand := range e.Operands {
+		operands[i] = operand.String()
+	}
+
+	return fmt.Sprintf("big: NaN from %s(%s): %s", e.Op, strings.Join(operands, ", "), e.Reason)
+}
+
+// Unwrap returns the reason this NaN was produced.
+func (e *NaNError) Unwrap() error {
+	return e.Reason
+}
+
+// NaNReason returns a *NaNError describing the operation that first produced this NaN,
+// or nil if this Decimal is a number or no reason was recorded, as for the NaN sentinel.
+// NaN operands propagate their reason through arithmetic, so the reason on the result
+// of a long calculation points at its first invalid step.
+func (d Decimal) NaNReason() error {
+	if !d.NaN() || d.reason == nil {
+		return nil
+	}
+
+	return d.reason
+}
+
+func nanDecimal(reason error, op string, operands ...Decimal) Decimal {
+	nanErr := &NaNError{Reason: reason}
+	if debugNaN {
+		nanErr.Op = op
+		nanErr.Operands = operands
+	}
+
+	return Decimal{nan: true, reason: nanErr}
+}
+
+// firstNaN returns the first NaN among the decimals, preferring one that records a
+// reason, and whether there was any.
+func firstNaN(decimals ...Decimal) (Decimal, bool) {
+	first, found := Decimal{}, false
+	for _, decimal := range decimals {
+		if !decimal.NaN() {
+			continue
+		}
+
+		if decimal.reason != nil {
+			return decimal, true
+		}
+
+		if !found {
+			first, found = decimal, true
+		}
+	}
+
+	return first, found
+}
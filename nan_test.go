@@ -0,0 +1,73 @@
+package big
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecimal_NaNReason(t *testing.T) {
+	t.Run("numbers have no reason", func(t *testing.T) {
+		assert.NoError(t, ONE.NaNReason())
+		assert.NoError(t, Decimal{}.NaNReason())
+	})
+
+	t.Run("NaN sentinel has no reason", func(t *testing.T) {
+		assert.NoError(t, NaN.NaNReason())
+		assert.NoError(t, NewFromString("NaN").NaNReason())
+	})
+
+	t.Run("division by zero", func(t *testing.T) {
+		d := ZERO.Div(ZERO)
+
+		assert.True(t, d.NaN())
+		assert.True(t, errors.Is(d.NaNReason(), ErrDivisionByZero))
+	})
+
+	t.Run("domain", func(t *testing.T) {
+		assert.True(t, errors.Is(NewFromInt(-4).Sqrt().NaNReason(), ErrDomain))
+	})
+
+	t.Run("parse failure", func(t *testing.T) {
+		reason := NewFromString("1.2.3").NaNReason()
+
+		var parseErr *ParseError
+		assert.True(t, errors.As(reason, &parseErr))
+		assert.True(t, errors.Is(reason, ErrSyntax))
+		assert.EqualValues(t, 3, parseErr.Offset)
+	})
+
+	t.Run("propagates the first reason", func(t *testing.T) {
+		bad := NewFromInt(-1).Sqrt()
+		result := TEN
+
+		for i := 0; i < 100; i++ {
+			result = result.Add(ONE)
+			if i == 50 {
+				result = result.Mul(bad)
+			}
+		}
+
+		result = result.Div(ZERO.Div(ZERO))
+
+		assert.True(t, result.NaN())
+		assert.True(t, errors.Is(result.NaNReason(), ErrDomain))
+		assert.True(t, errors.Is(MaxSlice(ONE, NaN, bad).NaNReason(), ErrDomain))
+	})
+
+	t.Run("operation and operands", func(t *testing.T) {
+		var nanErr *NaNError
+		assert.True(t, errors.As(NewFromInt(-4).Sqrt().NaNReason(), &nanErr))
+
+		if debugNaN {
+			assert.EqualValues(t, "Sqrt", nanErr.Op)
+			assert.EqualValues(t, []Decimal{NewFromInt(-4)}, nanErr.Operands)
+			assert.EqualError(t, nanErr, "big: NaN from Sqrt(-4): argument out of domain")
+		} else {
+			assert.Empty(t, nanErr.Op)
+			assert.Empty(t, nanErr.Operands)
+			assert.EqualError(t, nanErr, "big: NaN: argument out of domain")
+		}
+	})
+}
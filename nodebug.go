@@ -0,0 +1,5 @@
+//go:build !bigdebug
+
+package big
+
+const debugNaN = false
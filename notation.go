@@ -0,0 +1,105 @@
+package big
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// siPrefixes are the SI prefixes for the powers of 1000 from 10^-30 to 10^30.
+var siPrefixes = []string{"q", "r", "y", "z", "a", "f", "p", "n", "µ", "m", "", "k", "M", "G", "T", "P", "E", "Z", "Y", "R", "Q"}
+
+// Scientific returns this Decimal in scientific notation with the given number of
+// significant digits, rounded half away from zero, e.g. "1.2345e+07". A sigDigits of zero
+// or less writes every digit of the shortest decimal representation.
+func (d Decimal) Scientific(sigDigits int) string {
+	if !d.finite() {
+		return d.String()
+	}
+
+	sign, digits, exp := d.notationParts(sigDigits)
+	if len(digits) > 1 {
+		digits = digits[:1] + "." + digits[1:]
+	}
+
+	return fmt.Sprintf("%s%se%+03d", sign, digits, exp)
+}
+
+// Engineering returns this Decimal in engineering notation, whose exponent is a multiple
+// of three, with the given number of significant digits, e.g. "12.345e+06". Values with
+// fewer significant digits than integer digits are padded with zeros, so
+// Engineering(1) of 123 is "100e+00". A sigDigits of zero or less writes every digit of
+// the shortest decimal representation.
+func (d Decimal) Engineering(sigDigits int) string {
+	if !d.finite() {
+		return d.String()
+	}
+
+	sign, mantissa, exp := d.engineeringParts(sigDigits)
+	return fmt.Sprintf("%s%se%+03d", sign, mantissa, exp)
+}
+
+// SI returns this Decimal with the given number of significant digits and an SI prefix in
+// place of its engineering exponent, e.g. "12.3k", "4.56M" or "789µ". Values outside the
+// range of the SI prefixes, from quecto (10^-30) to quetta (10^30), are written in
+// engineering notation instead.
+func (d Decimal) SI(sigDigits int) string {
+	if !d.finite() {
+		return d.String()
+	}
+
+	sign, mantissa, exp := d.engineeringParts(sigDigits)
+
+	index := exp/3 + len(siPrefixes)/2
+	if index < 0 || index >= len(siPrefixes) {
+		return d.Engineering(sigDigits)
+	}
+
+	return sign + mantissa + siPrefixes[index]
+}
+
+// engineeringParts returns the sign, mantissa and exponent of this finite Decimal in
+// engineering notation.
+func (d Decimal) engineeringParts(sigDigits int) (string, string, int) {
+	sign, digits, exp := d.notationParts(sigDigits)
+
+	engExp := exp - ((exp%3)+3)%3
+	intDigits := exp - engExp + 1
+
+	if len(digits) < intDigits {
+		digits += strings.Repeat("0", intDigits-len(digits))
+	}
+
+	if len(digits) > intDigits {
+		return sign, digits[:intDigits] + "." + digits[intDigits:], engExp
+	}
+
+	return sign, digits, engExp
+}
+
+// notationParts returns the sign, significant digits and scientific exponent of this
+// finite Decimal, rounded and padded to sigDigits digits when sigDigits is positive.
+func (d Decimal) notationParts(sigDigits int) (string, string, int) {
+	coef, exp := d.decimalParts()
+	if sigDigits > 0 {
+		coef, exp = roundSignificant(coef, exp, sigDigits)
+	}
+
+	sign := ""
+	if coef.Sign() < 0 {
+		sign = "-"
+	}
+
+	digits := new(big.Int).Abs(coef).String()
+	exp += len(digits) - 1
+
+	if coef.Sign() == 0 {
+		exp = 0
+	}
+
+	if sigDigits > 0 && len(digits) < sigDigits {
+		digits += strings.Repeat("0", sigDigits-len(digits))
+	}
+
+	return sign, digits, exp
+}
@@ -0,0 +1,80 @@
+package big
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecimal_Scientific(t *testing.T) {
+	examples := []struct {
+		value     Decimal
+		sigDigits int
+		expected  string
+	}{
+		{NewFromString("12345000"), 5, "1.2345e+07"},
+		{NewFromString("12345678"), 5, "1.2346e+07"},
+		{NewFromString("-0.00012345"), 3, "-1.23e-04"},
+		{NewFromString("0.00012355"), 3, "1.24e-04"},
+		{NewFromString("9.995"), 3, "1.00e+01"},
+		{NewFromString("1"), 4, "1.000e+00"},
+		{NewFromString("7"), 1, "7e+00"},
+		{NewFromString("123456789012345678901234567890"), 0, "1.2345678901234567890123456789e+29"},
+		{NewFromString("1e-300"), 2, "1.0e-300"},
+		{ZERO, 3, "0.00e+00"},
+		{PosInf(), 3, "+Inf"},
+		{NaN, 3, "NaN"},
+	}
+
+	for _, ex := range examples {
+		assert.EqualValues(t, ex.expected, ex.value.Scientific(ex.sigDigits), "%s %d", ex.value, ex.sigDigits)
+	}
+}
+
+func TestDecimal_Engineering(t *testing.T) {
+	examples := []struct {
+		value     Decimal
+		sigDigits int
+		expected  string
+	}{
+		{NewFromString("12345000"), 5, "12.345e+06"},
+		{NewFromString("1234"), 2, "1.2e+03"},
+		{NewFromString("123456"), 4, "123.5e+03"},
+		{NewFromString("123"), 1, "100e+00"},
+		{NewFromString("999.7"), 3, "1.00e+03"},
+		{NewFromString("-0.000789"), 3, "-789e-06"},
+		{NewFromString("0.0123"), 0, "12.3e-03"},
+		{NewFromString("1e-10"), 2, "100e-12"},
+		{ZERO, 2, "0.0e+00"},
+		{NegInf(), 2, "-Inf"},
+	}
+
+	for _, ex := range examples {
+		assert.EqualValues(t, ex.expected, ex.value.Engineering(ex.sigDigits), "%s %d", ex.value, ex.sigDigits)
+	}
+}
+
+func TestDecimal_SI(t *testing.T) {
+	examples := []struct {
+		value     Decimal
+		sigDigits int
+		expected  string
+	}{
+		{NewFromString("12345"), 3, "12.3k"},
+		{NewFromString("4560000"), 3, "4.56M"},
+		{NewFromString("0.000789"), 3, "789µ"},
+		{NewFromString("-0.0015"), 2, "-1.5m"},
+		{NewFromString("999.95"), 4, "1.000k"},
+		{NewFromString("42"), 2, "42"},
+		{NewFromString("1.5e30"), 2, "1.5Q"},
+		{NewFromString("1e-30"), 1, "1q"},
+		{NewFromString("1.5e33"), 2, "1.5e+33"},
+		{NewFromString("1e-31"), 1, "100e-33"},
+		{ZERO, 1, "0"},
+		{NaN, 3, "NaN"},
+	}
+
+	for _, ex := range examples {
+		assert.EqualValues(t, ex.expected, ex.value.SI(ex.sigDigits), "%s %d", ex.value, ex.sigDigits)
+	}
+}
@@ -0,0 +1,346 @@
+package big
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+var (
+	// ErrSyntax indicates that a value does not have the right syntax for a Decimal.
+	ErrSyntax = errors.New("invalid syntax")
+
+	// ErrRange indicates that a value is out of the range a Decimal can represent.
+	ErrRange = errors.New("value out of range")
+)
+
+// ParseError records a failed attempt to parse a Decimal. It matches ErrSyntax or
+// ErrRange with errors.Is.
+type ParseError struct {
+	Input  string // the input being parsed
+	Offset int    // the byte offset in Input at which parsing failed
+	Reason string // a description of what was wrong at Offset
+	Err    error  // ErrSyntax or ErrRange
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("big: parsing %s: %s at offset %d: %s", strconv.Quote(e.Input), e.Err, e.Offset, e.Reason)
+}
+
+// Unwrap returns the underlying ErrSyntax or ErrRange.
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// Parse creates a new Decimal type from a string value, returning a *ParseError if the
+// string is not a valid number.
+//
+// Parse accepts an optional sign followed by a decimal mantissa with an optional decimal
+// or binary exponent ("1.5", "-.5", "2e10", "3p-2"), a signed "Inf" or "Infinity" in any
+// case, and "NaN".
+func Parse(str string) (Decimal, error) {
+	if str == "NaN" {
+		return Decimal{nan: true}, nil
+	}
+
+	exponentOffset, infinite, err := scanDecimal(str)
+	if err != nil {
+		return nanDecimal(err, "Parse"), err
+	}
+
+	if infinite {
+		if str[0] == '-' {
+			return NegInf(), nil
+		}
+
+		return PosInf(), nil
+	}
+
+	bfl := newFloat(decimalPrecision(str))
+	if _, _, err := bfl.Parse(str, 10); err != nil || bfl.IsInf() {
+		err := &ParseError{Input: str, Offset: exponentOffset, Reason: "exponent out of range", Err: ErrRange}
+		return nanDecimal(err, "Parse"), err
+	}
+
+	return Decimal{fl: bfl}, nil
+}
+
+// MustParse is like Parse but panics if the string cannot be parsed.
+func MustParse(str string) Decimal {
+	d, err := Parse(str)
+	if err != nil {
+		panic(err)
+	}
+
+	return d
+}
+
+// ParseFormatted parses a number written for display, such as "1,234,567.89",
+// "(1.234,00 €)" or "12.5%", using the separators of opts, returning a *ParseError if
+// the string is not a valid number.
+//
+// Group separators may only appear between integer digits, and when opts.GroupSize is
+// set the groups must have the sizes FormatWith would write. When the group separator is
+// a space, any space character is accepted in its place. The number may be surrounded by
+// whitespace and currency symbols, negated with a leading sign or by enclosing it in
+// parentheses, and followed by a percent sign, which divides it by 100. An ISO 4217
+// currency code such as "CHF" may precede or follow the number when whitespace separates
+// them, as in "USD 12.00" or "1’234.50 CHF". The Places, NegativeStyle and PlusSign
+// options are ignored.
+func ParseFormatted(str string, opts FormatOptions) (Decimal, error) {
+	text, err := scanFormatted(str, opts)
+	if err != nil {
+		return nanDecimal(err, "ParseFormatted"), err
+	}
+
+	return Parse(text)
+}
+
+// scanFormatted validates the syntax of a number written for display, returning the
+// same number in the syntax Parse accepts.
+func scanFormatted(str string, opts FormatOptions) (string, error) {
+	syntaxError := func(offset int, reason string) (string, error) {
+		return "", &ParseError{Input: str, Offset: offset, Reason: reason, Err: ErrSyntax}
+	}
+
+	decimalSeparator := opts.DecimalSeparator
+	if decimalSeparator == "" {
+		decimalSeparator = "."
+	}
+
+	var sign string
+	var parenthesized bool
+
+	i := 0
+prefix:
+	for i < len(str) {
+		r, size := utf8.DecodeRuneInString(str[i:])
+
+		switch {
+		case unicode.IsSpace(r) || unicode.Is(unicode.Sc, r):
+		case currencyCodeSize(str[i:]) > 0 && i+3 < len(str) && isSpaceAt(str, i+3):
+			size = 3
+		case r == '(' && !parenthesized && sign == "":
+			parenthesized, sign = true, "-"
+		case (r == '+' || r == '-') && sign == "":
+			sign = string(r)
+		default:
+			break prefix
+		}
+
+		i += size
+	}
+
+	var integer, fraction strings.Builder
+	var inFraction bool
+
+	groups := []int{0}
+	groupOffsets := []int{i}
+
+	for i < len(str) {
+		if isDigit(str[i]) {
+			if inFraction {
+				fraction.WriteByte(str[i])
+			} else {
+				integer.WriteByte(str[i])
+				groups[len(groups)-1]++
+			}
+
+			i++
+		} else if !inFraction && strings.HasPrefix(str[i:], decimalSeparator) {
+			inFraction = true
+			i += len(decimalSeparator)
+		} else if size := groupSeparatorSize(str[i:], opts.GroupSeparator); !inFraction && groups[len(groups)-1] > 0 && size > 0 {
+			i += size
+			groups = append(groups, 0)
+			groupOffsets = append(groupOffsets, i)
+		} else {
+			break
+		}
+	}
+
+	if integer.Len()+fraction.Len() == 0 {
+		if i < len(str) {
+			r, _ := utf8.DecodeRuneInString(str[i:])
+			return syntaxError(i, fmt.Sprintf("unexpected character %q", r))
+		}
+
+		return syntaxError(i, "number has no digits")
+	}
+
+	if opts.GroupSize > 0 && len(groups) > 1 {
+		size := opts.SecondaryGroupSize
+		if size <= 0 {
+			size = opts.GroupSize
+		}
+
+		for k, digits := range groups {
+			switch {
+			case k == len(groups)-1 && digits != opts.GroupSize:
+				return syntaxError(groupOffsets[k], fmt.Sprintf("digit group of %d digits, expected %d", digits, opts.GroupSize))
+			case k == 0 && digits > size:
+				return syntaxError(groupOffsets[k], fmt.Sprintf("digit group of %d digits, expected at most %d", digits, size))
+			case k > 0 && k < len(groups)-1 && digits != size:
+				return syntaxError(groupOffsets[k], fmt.Sprintf("digit group of %d digits, expected %d", digits, size))
+			}
+		}
+	}
+
+	var percent, closed bool
+	for i < len(str) {
+		r, size := utf8.DecodeRuneInString(str[i:])
+
+		switch {
+		case unicode.IsSpace(r) || unicode.Is(unicode.Sc, r):
+		case currencyCodeSize(str[i:]) > 0 && isSpaceBefore(str, i):
+			size = 3
+		case r == '%' && !percent:
+			percent = true
+		case r == ')' && parenthesized && !closed:
+			closed = true
+		default:
+			return syntaxError(i, fmt.Sprintf("unexpected character %q", r))
+		}
+
+		i += size
+	}
+
+	if parenthesized && !closed {
+		return syntaxError(len(str), "missing closing parenthesis")
+	}
+
+	text := sign + integer.String()
+	if fraction.Len() > 0 {
+		text += "." + fraction.String()
+	}
+
+	if percent {
+		text += "e-2"
+	}
+
+	return text, nil
+}
+
+// groupSeparatorSize returns the length of the group separator at the start of str, or
+// zero if str does not start with a group separator followed by a digit.
+func groupSeparatorSize(str, separator string) int {
+	if separator == "" {
+		return 0
+	}
+
+	size := 0
+	if strings.HasPrefix(str, separator) {
+		size = len(separator)
+	} else if r, n := utf8.DecodeRuneInString(separator); n == len(separator) && unicode.IsSpace(r) {
+		if r, n := utf8.DecodeRuneInString(str); unicode.IsSpace(r) {
+			size = n
+		}
+	}
+
+	if size == 0 || size >= len(str) || !isDigit(str[size]) {
+		return 0
+	}
+
+	return size
+}
+
+// currencyCodeSize returns 3 if str starts with an ISO 4217 currency code that is not
+// followed by another letter or digit, and zero otherwise.
+func currencyCodeSize(str string) int {
+	if len(str) < 3 {
+		return 0
+	} else if _, err := LookupCurrency(str[:3]); err != nil {
+		return 0
+	}
+
+	if r, _ := utf8.DecodeRuneInString(str[3:]); len(str) > 3 && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+		return 0
+	}
+
+	return 3
+}
+
+// isSpaceAt returns true if the rune at offset i of str is a space.
+func isSpaceAt(str string, i int) bool {
+	r, _ := utf8.DecodeRuneInString(str[i:])
+	return unicode.IsSpace(r)
+}
+
+// isSpaceBefore returns true if the rune before offset i of str is a space.
+func isSpaceBefore(str string, i int) bool {
+	r, _ := utf8.DecodeLastRuneInString(str[:i])
+	return unicode.IsSpace(r)
+}
+
+// scanDecimal validates the syntax of str, returning the offset of its exponent (or the
+// end of the string if it has none) and whether it spells an infinity.
+func scanDecimal(str string) (int, bool, error) {
+	syntaxError := func(offset int, reason string) (int, bool, error) {
+		return 0, false, &ParseError{Input: str, Offset: offset, Reason: reason, Err: ErrSyntax}
+	}
+
+	if str == "" {
+		return syntaxError(0, "empty string")
+	}
+
+	i := 0
+	if str[i] == '+' || str[i] == '-' {
+		i++
+	}
+
+	if rest := str[i:]; strings.EqualFold(rest, "inf") || strings.EqualFold(rest, "infinity") {
+		return len(str), true, nil
+	}
+
+	digits := 0
+	for ; i < len(str) && isDigit(str[i]); i++ {
+		digits++
+	}
+
+	if i < len(str) && str[i] == '.' {
+		for i++; i < len(str) && isDigit(str[i]); i++ {
+			digits++
+		}
+	}
+
+	if digits == 0 {
+		if i < len(str) {
+			return syntaxError(i, fmt.Sprintf("unexpected character %q", str[i]))
+		}
+
+		return syntaxError(i, "number has no digits")
+	}
+
+	exponentOffset := i
+	if i < len(str) && (str[i] == 'e' || str[i] == 'E' || str[i] == 'p' || str[i] == 'P') {
+		i++
+		if i < len(str) && (str[i] == '+' || str[i] == '-') {
+			i++
+		}
+
+		start := i
+		for ; i < len(str) && isDigit(str[i]); i++ {
+		}
+
+		if i == start {
+			if i < len(str) {
+				return syntaxError(i, fmt.Sprintf("unexpected character %q", str[i]))
+			}
+
+			return syntaxError(i, "exponent has no digits")
+		}
+	}
+
+	if i < len(str) {
+		return syntaxError(i, fmt.Sprintf("unexpected character %q", str[i]))
+	}
+
+	return exponentOffset, false, nil
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
@@ -0,0 +1,201 @@
+package big
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		examples := map[string]string{
+			"1.87":   "1.87",
+			"-0.5":   "-0.5",
+			"+.5":    "0.5",
+			"5.":     "5",
+			"1.5e3":  "1500",
+			"25E-2":  "0.25",
+			"3p2":    "12",
+			"Inf":    "+Inf",
+			"-inf":   "-Inf",
+			"NaN":    "NaN",
+			"000042": "42",
+		}
+
+		for input, expected := range examples {
+			d, err := Parse(input)
+
+			assert.NoError(t, err, input)
+			assert.EqualValues(t, expected, d.String(), input)
+		}
+	})
+
+	t.Run("syntax errors", func(t *testing.T) {
+		examples := []struct {
+			input  string
+			offset int
+			reason string
+		}{
+			{"", 0, "empty string"},
+			{"abc", 0, "unexpected character 'a'"},
+			{"1.2x", 3, "unexpected character 'x'"},
+			{"1,000", 1, "unexpected character ','"},
+			{"-", 1, "number has no digits"},
+			{".", 1, "number has no digits"},
+			{"1e", 2, "exponent has no digits"},
+			{"1e+x", 3, "unexpected character 'x'"},
+			{" 1", 0, "unexpected character ' '"},
+			{"nan", 0, "unexpected character 'n'"},
+		}
+
+		for _, ex := range examples {
+			d, err := Parse(ex.input)
+
+			var parseErr *ParseError
+			if assert.True(t, errors.As(err, &parseErr), ex.input) {
+				assert.True(t, errors.Is(err, ErrSyntax), ex.input)
+				assert.EqualValues(t, ex.input, parseErr.Input)
+				assert.EqualValues(t, ex.offset, parseErr.Offset, ex.input)
+				assert.EqualValues(t, ex.reason, parseErr.Reason, ex.input)
+			}
+			assert.True(t, d.NaN())
+		}
+	})
+
+	t.Run("range errors", func(t *testing.T) {
+		for _, input := range []string{"1e9999999999", "1e-9999999999", "1e646456993", "1e999999999999999999999"} {
+			d, err := Parse(input)
+
+			var parseErr *ParseError
+			if assert.True(t, errors.As(err, &parseErr), input) {
+				assert.True(t, errors.Is(err, ErrRange), input)
+				assert.EqualValues(t, 1, parseErr.Offset, input)
+			}
+			assert.True(t, d.NaN())
+		}
+	})
+
+	t.Run("error message", func(t *testing.T) {
+		_, err := Parse("1.2x")
+
+		assert.EqualError(t, err, `big: parsing "1.2x": invalid syntax at offset 3: unexpected character 'x'`)
+	})
+}
+
+func TestMustParse(t *testing.T) {
+	assert.EqualValues(t, "1.5", MustParse("1.5").String())
+	assert.Panics(t, func() {
+		MustParse("1.5.5")
+	})
+}
+
+func TestDecimal_UnmarshalJSON_ParseError(t *testing.T) {
+	var d Decimal
+	err := d.UnmarshalJSON([]byte(`"12a"`))
+
+	assert.True(t, errors.Is(err, ErrSyntax))
+}
+
+func TestParseFormatted(t *testing.T) {
+	examples := []struct {
+		input    string
+		opts     FormatOptions
+		expected string
+	}{
+		{"1,234,567.89", FormatEnUS, "1234567.89"},
+		{"1.234.567,89", FormatDeDE, "1234567.89"},
+		{"1 234 567,89", FormatFrFR, "1234567.89"},
+		{"1 234 567,89", FormatFrFR, "1234567.89"},
+		{"1 234,5", FormatFrFR, "1234.5"},
+		{"12,34,567.89", FormatEnIN, "1234567.89"},
+		{"1’234’567.89", FormatChCH, "1234567.89"},
+		{"(1,234.00)", FormatEnUS, "-1234"},
+		{"($1,234.00)", FormatEnUS, "-1234"},
+		{"$(1,234.00)", FormatEnUS, "-1234"},
+		{"-$5", FormatEnUS, "-5"},
+		{"$-5", FormatEnUS, "-5"},
+		{"+42", FormatEnUS, "42"},
+		{" 1.234,56 € ", FormatDeDE, "1234.56"},
+		{"£1,000", FormatEnUS, "1000"},
+		{"¥ 1500", FormatEnUS, "1500"},
+		{"12.5%", FormatEnUS, "0.125"},
+		{"(0,5 %)", FormatDeDE, "-0.005"},
+		{".5", FormatEnUS, "0.5"},
+		{"1234567.89", FormatEnUS, "1234567.89"},
+		{"0.1", FormatOptions{}, "0.1"},
+		{"1’234.50 CHF", FormatChCH, "1234.5"},
+		{"USD 12.00", FormatEnUS, "12"},
+		{"(EUR 1.234,00)", FormatDeDE, "-1234"},
+		{"-12 usd", FormatEnUS, "-12"},
+		{"12.5 % JPY", FormatEnUS, "0.125"},
+	}
+
+	for _, ex := range examples {
+		d, err := ParseFormatted(ex.input, ex.opts)
+
+		if assert.NoError(t, err, ex.input) {
+			assert.EqualValues(t, ex.expected, d.String(), ex.input)
+		}
+	}
+
+	t.Run("syntax errors", func(t *testing.T) {
+		examples := []struct {
+			input  string
+			opts   FormatOptions
+			offset int
+			reason string
+		}{
+			{"", FormatEnUS, 0, "number has no digits"},
+			{"$", FormatEnUS, 1, "number has no digits"},
+			{"abc", FormatEnUS, 0, "unexpected character 'a'"},
+			{"1,234.5x", FormatEnUS, 7, "unexpected character 'x'"},
+			{"1,234,", FormatEnUS, 5, "unexpected character ','"},
+			{",123", FormatEnUS, 0, "unexpected character ','"},
+			{"1.234,5", FormatEnUS, 5, "unexpected character ','"},
+			{"1,23,456", FormatEnUS, 2, "digit group of 2 digits, expected 3"},
+			{"12,3456", FormatEnUS, 3, "digit group of 4 digits, expected 3"},
+			{"1234,567", FormatEnUS, 0, "digit group of 4 digits, expected at most 3"},
+			{"1,5", FormatEnUS, 2, "digit group of 1 digits, expected 3"},
+			{"(1,234", FormatEnUS, 6, "missing closing parenthesis"},
+			{"1,234)", FormatEnUS, 5, "unexpected character ')'"},
+			{"-(5)", FormatEnUS, 1, "unexpected character '('"},
+			{"5%%", FormatEnUS, 2, "unexpected character '%'"},
+			{"1,234", FormatOptions{}, 1, "unexpected character ','"},
+			{"12CHF", FormatEnUS, 2, "unexpected character 'C'"},
+			{"USD12", FormatEnUS, 0, "unexpected character 'U'"},
+			{"12 XYZ", FormatEnUS, 3, "unexpected character 'X'"},
+			{"12 CHFX", FormatEnUS, 3, "unexpected character 'C'"},
+		}
+
+		for _, ex := range examples {
+			d, err := ParseFormatted(ex.input, ex.opts)
+
+			var parseErr *ParseError
+			if assert.True(t, errors.As(err, &parseErr), ex.input) {
+				assert.True(t, errors.Is(err, ErrSyntax), ex.input)
+				assert.EqualValues(t, ex.input, parseErr.Input, ex.input)
+				assert.EqualValues(t, ex.offset, parseErr.Offset, ex.input)
+				assert.EqualValues(t, ex.reason, parseErr.Reason, ex.input)
+			}
+			assert.True(t, d.NaN())
+			assert.True(t, errors.Is(d.NaNReason(), ErrSyntax), ex.input)
+		}
+	})
+
+	t.Run("round trips FormatWith", func(t *testing.T) {
+		for _, opts := range []FormatOptions{FormatEnUS, FormatDeDE, FormatFrFR, FormatEnIN, FormatChCH} {
+			for _, s := range []string{"-1234567.89", "0.05", "987654321012.5"} {
+				negative := opts
+				negative.NegativeStyle = NegativeParentheses
+
+				for _, o := range []FormatOptions{opts, negative} {
+					d, err := ParseFormatted(NewFromString(s).FormatWith(o), o)
+
+					assert.NoError(t, err)
+					assert.True(t, d.EQ(NewFromString(s)), "%s %+v", s, o)
+				}
+			}
+		}
+	})
+}
@@ -0,0 +1,143 @@
+package big
+
+import (
+	"math"
+	"math/big"
+)
+
+// PowDecimal returns x raised to the power of exp, which need not be an integer.
+//
+// Negative bases are only defined for integer exponents, and are NaN otherwise. Special
+// cases follow IEEE-754 pow: zero to a negative power is +Inf, infinite exponents are 0,
+// 1 or +Inf depending on whether |x| is below, at or above one, and infinite bases are
+// 0 or infinite depending on the sign of exp.
+func (c Context) PowDecimal(x, exp Decimal) Decimal {
+	return nanGuard(func() Decimal {
+		switch {
+		case exp.IsZero():
+			return oneDecimal()
+		case exp.IsInf(0):
+			return powInfExponent(x, exp)
+		case x.IsInf(0) || x.IsZero():
+			return powSpecialBase(x, exp)
+		}
+
+		integer := exp.value().IsInt()
+		if x.Sign() < 0 && !integer {
+			return nanDecimal(ErrDomain, "PowDecimal", x, exp)
+		}
+
+		z := c.newFloat(x.value().Prec())
+		wp := z.Prec() + guardPrecision
+
+		if n, accuracy := exp.value().Int64(); integer && accuracy == big.Exact && abs64(n) <= math.MaxInt32 {
+			return Decimal{fl: z.Set(NewContext(wp, big.ToNearestEven).Pow(x, int(n)).value())}
+		}
+
+		result := expLnProduct(new(big.Float).Abs(x.value()), exp.value(), wp)
+		if x.Sign() < 0 && isOdd(exp.value()) {
+			result.Neg(result)
+		}
+
+		return Decimal{fl: z.Set(result)}
+	}, x, exp)
+}
+
+// Root returns the nth root of x. Negative roots are the reciprocals of positive ones,
+// odd roots of negative numbers are negative, and even roots of negative numbers, like
+// the zeroth root of any number, are NaN.
+func (c Context) Root(x Decimal, n int) Decimal {
+	return nanGuard(func() Decimal {
+		switch {
+		case n == 0 || (x.Sign() < 0 && n%2 == 0):
+			return nanDecimal(ErrDomain, "Root", x, NewFromInt(n))
+		case n == 1 || ((x.IsZero() || x.IsInf(0)) && n > 0):
+			return x
+		case n == -1 || x.IsZero() || x.IsInf(0):
+			return c.Div(oneDecimal(), x)
+		case n == 2:
+			return c.Sqrt(x)
+		}
+
+		z := c.newFloat(x.value().Prec())
+		wp := z.Prec() + guardPrecision
+
+		reciprocal := newFloatPrec(wp).SetInt64(1)
+		reciprocal.Quo(reciprocal, newFloatPrec(wp).SetInt64(int64(n)))
+
+		result := expLnProduct(new(big.Float).Abs(x.value()), reciprocal, wp)
+		if x.Sign() < 0 {
+			result.Neg(result)
+		}
+
+		return Decimal{fl: z.Set(result)}
+	}, x)
+}
+
+// Cbrt returns the cube root of x.
+func (c Context) Cbrt(x Decimal) Decimal {
+	return c.Root(x, 3)
+}
+
+// PowDecimal returns this Decimal raised to the power of exp, which need not be an integer.
+func (d Decimal) PowDecimal(exp Decimal) Decimal {
+	return DefaultContext.PowDecimal(d, exp)
+}
+
+// Root returns the nth root of this Decimal.
+func (d Decimal) Root(n int) Decimal {
+	return DefaultContext.Root(d, n)
+}
+
+// Cbrt returns the cube root of this Decimal.
+func (d Decimal) Cbrt() Decimal {
+	return DefaultContext.Cbrt(d)
+}
+
+func powInfExponent(x, exp Decimal) Decimal {
+	switch cmp := x.Abs().Cmp(oneDecimal()); {
+	case cmp == 0:
+		return oneDecimal()
+	case (cmp > 0) == exp.IsInf(1):
+		return PosInf()
+	default:
+		return zeroDecimal()
+	}
+}
+
+func powSpecialBase(x, exp Decimal) Decimal {
+	result := PosInf()
+	if (exp.Sign() > 0) == x.IsZero() {
+		result = zeroDecimal()
+	}
+
+	if x.value().Signbit() && exp.value().IsInt() && isOdd(exp.value()) {
+		return result.Neg()
+	}
+
+	return result
+}
+
+// expLnProduct returns e^(y ln x), for finite x > 0 and finite y, to prec bits.
+func expLnProduct(x, y *big.Float, prec uint) *big.Float {
+	wp := prec + guardPrecision
+
+	product := lnFloat(x, wp)
+	product.Mul(product, y)
+
+	// The absolute error of the product becomes the relative error of the result, so its
+	// integer bits must be computed on top of the working precision.
+	if exp := product.MantExp(nil); exp > 0 {
+		wp += uint(exp)
+		product = lnFloat(x, wp)
+		product.Mul(product, newFloatPrec(wp).Set(y))
+	}
+
+	return expFloat(product, prec)
+}
+
+// isOdd reports whether the integer x is odd.
+func isOdd(x *big.Float) bool {
+	integer, _ := x.Int(nil)
+	return integer.Bit(0) == 1
+}
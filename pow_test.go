@@ -0,0 +1,181 @@
+package big
+
+import (
+	"errors"
+	mathbig "math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecimal_PowDecimal(t *testing.T) {
+	validateEqExamples(t,
+		equalExample{
+			value:    NewFromInt(4).PowDecimal(NewFromString("0.5")),
+			expected: "2",
+		},
+		equalExample{
+			value:    NewFromInt(8).PowDecimal(NewFromString("-1").Div(NewFromInt(3))),
+			expected: "0.5",
+		},
+		equalExample{
+			value:    NewFromInt(-2).PowDecimal(NewFromInt(3)),
+			expected: "-8",
+		},
+		equalExample{
+			value:    NewFromInt(-2).PowDecimal(NewFromInt(-2)),
+			expected: "0.25",
+		},
+		equalExample{
+			value:    NewFromInt(2).PowDecimal(NewFromInt(100)),
+			expected: "1.267650600228229401496703205376e+30",
+		},
+		equalExample{
+			value:    NewFromString("-1").PowDecimal(NewFromString("1e100")),
+			expected: "1",
+		},
+		equalExample{
+			value:    NewFromString("-1").PowDecimal(NewFromString("1e70").Add(ONE)),
+			expected: "-1",
+		},
+		equalExample{
+			value:    TEN.PowDecimal(ZERO),
+			expected: "1",
+		},
+		equalExample{
+			value:    NaN.PowDecimal(ONE),
+			expected: "NaN",
+		},
+		equalExample{
+			value:    TEN.PowDecimal(NaN),
+			expected: "NaN",
+		},
+	)
+
+	t.Run("exact integer powers", func(t *testing.T) {
+		assert.True(t, NewFromInt(2).PowDecimal(NewFromInt(100)).EQ(NewFromString("1267650600228229401496703205376")))
+		assert.True(t, NewFromInt(3).PowDecimal(NewFromInt(-2)).EQ(ONE.Div(NewFromInt(9))))
+	})
+
+	t.Run("fractional exponents", func(t *testing.T) {
+		// An annual return from a 10% gain over 100 trading days: 1.1^(252/100)
+		annualized := NewFromString("1.1").PowDecimal(NewFromInt(252).Div(NewFromInt(100)))
+		assertDigits(t, "1.2714800976547180896601329394149654287472316811220404190021824100280247106838115", annualized, 70)
+		assertDigits(t, "1.414213562373095048801688724209698078569671875376948073176679737990732", NewFromInt(2).PowDecimal(NewFromString("0.5")), 70)
+		assertDigits(t, "8.8249774992670729945393968023485758870089227961224180296680194061303433275511428", NewFromInt(2).PowDecimal(NewFromString("3.1415926")), 70)
+	})
+
+	t.Run("domain", func(t *testing.T) {
+		result := NewFromInt(-8).PowDecimal(NewFromString("0.5"))
+
+		assert.True(t, result.NaN())
+		assert.True(t, errors.Is(result.NaNReason(), ErrDomain))
+	})
+
+	t.Run("special cases", func(t *testing.T) {
+		validateEqExamples(t,
+			equalExample{
+				value:    ZERO.PowDecimal(NewFromString("0.5")),
+				expected: "0",
+			},
+			equalExample{
+				value:    ZERO.PowDecimal(NewFromString("-0.5")),
+				expected: "+Inf",
+			},
+			equalExample{
+				value:    ZERO.Neg().PowDecimal(NewFromInt(-3)),
+				expected: "-Inf",
+			},
+			equalExample{
+				value:    NegInf().PowDecimal(NewFromInt(3)),
+				expected: "-Inf",
+			},
+			equalExample{
+				value:    NegInf().PowDecimal(NewFromString("0.5")),
+				expected: "+Inf",
+			},
+			equalExample{
+				value:    PosInf().PowDecimal(NewFromString("-0.5")),
+				expected: "0",
+			},
+			equalExample{
+				value:    TEN.PowDecimal(PosInf()),
+				expected: "+Inf",
+			},
+			equalExample{
+				value:    TEN.PowDecimal(NegInf()),
+				expected: "0",
+			},
+			equalExample{
+				value:    NewFromString("0.5").PowDecimal(NegInf()),
+				expected: "+Inf",
+			},
+			equalExample{
+				value:    ONE.Neg().PowDecimal(PosInf()),
+				expected: "1",
+			},
+		)
+	})
+}
+
+func TestDecimal_Root(t *testing.T) {
+	validateEqExamples(t,
+		equalExample{
+			value:    NewFromInt(27).Root(3),
+			expected: "3",
+		},
+		equalExample{
+			value:    NewFromInt(-32).Root(5),
+			expected: "-2",
+		},
+		equalExample{
+			value:    NewFromInt(16).Root(-4),
+			expected: "0.5",
+		},
+		equalExample{
+			value:    NewFromInt(16).Root(2),
+			expected: "4",
+		},
+		equalExample{
+			value:    NewFromInt(16).Root(1),
+			expected: "16",
+		},
+		equalExample{
+			value:    ZERO.Root(-2),
+			expected: "+Inf",
+		},
+		equalExample{
+			value:    PosInf().Root(-2),
+			expected: "0",
+		},
+		equalExample{
+			value:    NegInf().Root(3),
+			expected: "-Inf",
+		},
+		equalExample{
+			value:    NewFromInt(-16).Root(4),
+			expected: "NaN",
+		},
+		equalExample{
+			value:    NewFromInt(16).Root(0),
+			expected: "NaN",
+		},
+	)
+
+	assert.True(t, NewFromInt(1024).Root(10).EQ(NewFromInt(2)))
+	assert.True(t, errors.Is(NewFromInt(-16).Root(4).NaNReason(), ErrDomain))
+	assertDigits(t, "1.1486983549970350067986269467779275894438508890977975055137111184936032062535131", NewFromInt(2).Root(5), 65)
+}
+
+func TestDecimal_Cbrt(t *testing.T) {
+	assert.True(t, NewFromInt(-125).Cbrt().EQ(NewFromInt(-5)))
+	assertDigits(t, "1.259921049894873164767210607278228350570251464701507980081975112155", NewFromInt(2).Cbrt(), 65)
+}
+
+func TestContext_PowDecimal(t *testing.T) {
+	ctx := NewContextDigits(20, mathbig.ToNearestEven)
+
+	assert.EqualValues(t, 67, ctx.PowDecimal(NewFromInt(2), NewFromString("0.5")).value().Prec())
+	assert.EqualValues(t, 67, ctx.Root(NewFromInt(2), 7).value().Prec())
+	assert.EqualValues(t, 67, ctx.Cbrt(NewFromInt(2)).value().Prec())
+}
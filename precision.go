@@ -0,0 +1,67 @@
+package big
+
+import "math/big"
+
+// RoundSignificant returns this Decimal rounded half away from zero to n significant
+// digits, so NewFromString("123456").RoundSignificant(2) is 120000. Like Round, it
+// operates on the shortest decimal representation of this Decimal. n must be positive,
+// otherwise the result is NaN.
+func (d Decimal) RoundSignificant(n int) Decimal {
+	if n < 1 {
+		return nanDecimal(ErrDomain, "RoundSignificant", d, NewFromInt(n))
+	} else if !d.finite() {
+		return d
+	}
+
+	coef, exp := d.decimalParts()
+	if len(new(big.Int).Abs(coef).String()) <= n {
+		return d
+	}
+
+	rounded, exp := roundSignificant(coef, exp, n)
+	return newFromScaled(rounded, -exp)
+}
+
+// SignificantDigits returns the number of significant digits in the shortest decimal
+// representation of this Decimal, which is 1 for zero and 0 for NaN and the infinities.
+func (d Decimal) SignificantDigits() int {
+	if !d.finite() {
+		return 0
+	}
+
+	coef, _ := d.decimalParts()
+	return len(new(big.Int).Abs(coef).String())
+}
+
+// Scale returns the number of digits after the decimal point needed to write this Decimal
+// exactly, as String does in plain notation, so NewFromString("1.250").Scale() is 2. It
+// is 0 for integers, NaN and the infinities.
+func (d Decimal) Scale() int {
+	if !d.finite() {
+		return 0
+	}
+
+	_, exp := d.decimalParts()
+	return max(-exp, 0)
+}
+
+// Exponent returns the exponent of this Decimal in scientific notation, which is the
+// power of ten of its leading digit, so NewFromString("0.0123").Exponent() is -2. It is 0
+// for zero, NaN and the infinities.
+func (d Decimal) Exponent() int {
+	if !d.finite() || d.IsZero() {
+		return 0
+	}
+
+	coef, exp := d.decimalParts()
+	return exp + len(new(big.Int).Abs(coef).String()) - 1
+}
+
+// Precision returns the precision of this Decimal's mantissa in bits, or 0 for NaN.
+func (d Decimal) Precision() uint {
+	if d.NaN() {
+		return 0
+	}
+
+	return d.value().Prec()
+}
@@ -0,0 +1,115 @@
+package big
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecimal_RoundSignificant(t *testing.T) {
+	validateEqExamples(t,
+		equalExample{
+			value:    NewFromString("123456").RoundSignificant(2),
+			expected: "120000",
+		},
+		equalExample{
+			value:    NewFromString("-0.00123456").RoundSignificant(3),
+			expected: "-0.00123",
+		},
+		equalExample{
+			value:    NewFromString("0.00125").RoundSignificant(2),
+			expected: "0.0013",
+		},
+		equalExample{
+			value:    NewFromString("99.96").RoundSignificant(3),
+			expected: "100",
+		},
+		equalExample{
+			value:    NewFromString("1.5").RoundSignificant(10),
+			expected: "1.5",
+		},
+		equalExample{
+			value:    NewFromString("6.02214076e+23").RoundSignificant(3),
+			expected: "6.02e+23",
+		},
+		equalExample{
+			value:    ZERO.RoundSignificant(1),
+			expected: "0",
+		},
+		equalExample{
+			value:    NegInf().RoundSignificant(1),
+			expected: "-Inf",
+		},
+		equalExample{
+			value:    NaN.RoundSignificant(1),
+			expected: "NaN",
+		},
+	)
+
+	assert.True(t, NewFromString("123456").RoundSignificant(2).EQ(NewFromString("120000")))
+	assert.True(t, errors.Is(ONE.RoundSignificant(0).NaNReason(), ErrDomain))
+}
+
+func TestDecimal_SignificantDigits(t *testing.T) {
+	examples := map[string]int{
+		"123.45":    5,
+		"-0.00012":  2,
+		"1200":      2,
+		"1.20":      2,
+		"0":         1,
+		"1e100":     1,
+		"3.1415926": 8,
+	}
+
+	for input, expected := range examples {
+		assert.EqualValues(t, expected, NewFromString(input).SignificantDigits(), input)
+	}
+
+	assert.Zero(t, NaN.SignificantDigits())
+	assert.Zero(t, PosInf().SignificantDigits())
+}
+
+func TestDecimal_Scale(t *testing.T) {
+	examples := map[string]int{
+		"123.45":   2,
+		"-0.00012": 5,
+		"1.250":    2,
+		"1200":     0,
+		"0":        0,
+		"1e-20":    20,
+	}
+
+	for input, expected := range examples {
+		assert.EqualValues(t, expected, NewFromString(input).Scale(), input)
+	}
+
+	assert.EqualValues(t, 55, NewDecimal(0.1).Scale())
+	assert.Zero(t, NaN.Scale())
+	assert.Zero(t, NegInf().Scale())
+}
+
+func TestDecimal_Exponent(t *testing.T) {
+	examples := map[string]int{
+		"123.45": 2,
+		"0.0123": -2,
+		"-1":     0,
+		"9.99":   0,
+		"1e100":  100,
+		"0":      0,
+	}
+
+	for input, expected := range examples {
+		assert.EqualValues(t, expected, NewFromString(input).Exponent(), input)
+	}
+
+	assert.Zero(t, NaN.Exponent())
+}
+
+func TestDecimal_Precision(t *testing.T) {
+	assert.EqualValues(t, 256, NewFromString("1.5").Precision())
+	assert.EqualValues(t, 256, NewFromInt(7).Precision())
+	assert.EqualValues(t, 113, NewContextDigits(34, 0).Add(ONE, ONE).Precision())
+	assert.Greater(t, NewFromString("1.00000000000000000000000000000000000000000000000000000000000000000000000000000000000001").Precision(), uint(256))
+	assert.Zero(t, NaN.Precision())
+}
@@ -0,0 +1,180 @@
+package big
+
+import (
+	"math/big"
+	"sort"
+)
+
+// QuantileMethod selects one of the nine sample quantile definitions of Hyndman and Fan
+// (1996), numbered as in R's quantile function. The zero value is QuantileType7.
+type QuantileMethod int
+
+// The quantile methods supported by Quantile. Types 1 to 3 are discontinuous and return
+// one of the values or, for type 2, the mean of two; types 4 to 9 interpolate linearly
+// between adjacent values.
+const (
+	// QuantileDefault is QuantileType7, the default of R and NumPy.
+	QuantileDefault QuantileMethod = iota
+	// QuantileType1 is the inverse of the empirical distribution function ("inverted_cdf"
+	// in NumPy).
+	QuantileType1
+	// QuantileType2 is like QuantileType1, but averages at discontinuities
+	// ("averaged_inverted_cdf").
+	QuantileType2
+	// QuantileType3 is the observation closest to n × p, with ties to the even
+	// observation ("closest_observation").
+	QuantileType3
+	// QuantileType4 interpolates the empirical distribution function
+	// ("interpolated_inverted_cdf").
+	QuantileType4
+	// QuantileType5 is the piecewise linear function whose knots are the midpoints of the
+	// steps of the empirical distribution function ("hazen").
+	QuantileType5
+	// QuantileType6 uses p(k) = k / (n + 1) ("weibull").
+	QuantileType6
+	// QuantileType7 uses p(k) = (k - 1) / (n - 1) ("linear").
+	QuantileType7
+	// QuantileType8 is approximately median-unbiased ("median_unbiased").
+	QuantileType8
+	// QuantileType9 is approximately unbiased for normally distributed values
+	// ("normal_unbiased").
+	QuantileType9
+)
+
+// Sort sorts a slice of decimals in ascending order, in place, using Cmp. Unlike Cmp,
+// which orders NaN below every number, Sort places NaN values last. The sort is stable.
+func Sort(decimals []Decimal) {
+	sort.SliceStable(decimals, func(i, j int) bool {
+		if decimals[i].NaN() || decimals[j].NaN() {
+			return !decimals[i].NaN() && decimals[j].NaN()
+		}
+
+		return decimals[i].Cmp(decimals[j]) < 0
+	})
+}
+
+// Quantile returns the q-quantile of the values, for q from 0 to 1, using the given
+// method. Like MaxSlice, it returns the first NaN in the values if there is one, and 0
+// for no values. A q outside [0, 1], or an unknown method, is NaN.
+//
+// Like Round, Quantile operates on the shortest decimal representation of q, so the
+// position of the quantile between two values is exact, and the result is only rounded
+// when it is interpolated.
+func Quantile(values []Decimal, q Decimal, method QuantileMethod) Decimal {
+	operands := append([]Decimal{q}, values...)
+
+	if nan, ok := firstNaN(operands...); ok {
+		return nan
+	} else if !q.finite() || q.Sign() < 0 || q.GT(oneDecimal()) || method < QuantileDefault || method > QuantileType9 {
+		return nanDecimal(ErrDomain, "Quantile", operands...)
+	} else if len(values) == 0 {
+		return zeroDecimal()
+	}
+
+	sorted := sortedCopy(values)
+
+	if method == QuantileDefault {
+		method = QuantileType7
+	}
+
+	coef, exp := q.decimalParts()
+	p := new(big.Rat).SetInt(coef)
+	if exp < 0 {
+		p.Quo(p, new(big.Rat).SetInt(pow10(-exp)))
+	} else {
+		p.Mul(p, new(big.Rat).SetInt(pow10(exp)))
+	}
+
+	n := big.NewRat(int64(len(sorted)), 1)
+
+	// h is the (1-based) position of the quantile between the sorted values.
+	var h *big.Rat
+	switch method {
+	case QuantileType1, QuantileType2, QuantileType4:
+		h = new(big.Rat).Mul(n, p)
+	case QuantileType3, QuantileType5:
+		h = new(big.Rat).Add(new(big.Rat).Mul(n, p), big.NewRat(1, 2))
+		if method == QuantileType3 {
+			h.Sub(h, big.NewRat(1, 1))
+		}
+	case QuantileType6:
+		h = new(big.Rat).Mul(n.Add(n, big.NewRat(1, 1)), p)
+	case QuantileType7:
+		h = new(big.Rat).Add(new(big.Rat).Mul(n.Sub(n, big.NewRat(1, 1)), p), big.NewRat(1, 1))
+	case QuantileType8:
+		h = new(big.Rat).Add(new(big.Rat).Mul(n.Add(n, big.NewRat(1, 3)), p), big.NewRat(1, 3))
+	case QuantileType9:
+		h = new(big.Rat).Add(new(big.Rat).Mul(n.Add(n, big.NewRat(1, 4)), p), big.NewRat(3, 8))
+	}
+
+	j := new(big.Int).Div(h.Num(), h.Denom())
+	g := new(big.Rat).Sub(h, new(big.Rat).SetInt(j))
+
+	at := func(k int64) Decimal {
+		return sorted[min(max(k, 1), int64(len(sorted)))-1]
+	}
+
+	if !j.IsInt64() {
+		return at(int64(len(sorted)))
+	}
+
+	lower, upper := at(j.Int64()), at(j.Int64()+1)
+
+	switch {
+	case method == QuantileType1 && g.Sign() == 0:
+		return lower
+	case method == QuantileType2 && g.Sign() == 0:
+		return Mean(lower, upper)
+	case method == QuantileType3 && g.Sign() == 0 && j.Bit(0) == 0:
+		return lower
+	case method <= QuantileType3:
+		return upper
+	case g.Sign() == 0 || lower.EQ(upper):
+		return lower
+	}
+
+	difference := upper.Sub(lower)
+	return lower.Add(difference.Mul(NewFromBigInt(g.Num())).Div(NewFromBigInt(g.Denom())))
+}
+
+// Percentile returns the pth percentile of the values, for p from 0 to 100, using the
+// given method. See Quantile.
+func Percentile(values []Decimal, p Decimal, method QuantileMethod) Decimal {
+	if !p.finite() {
+		return Quantile(values, p, method)
+	}
+
+	coef, exp := p.decimalParts()
+	return Quantile(values, newFromScaled(coef, 2-exp), method)
+}
+
+// Quantiles returns the q-quantiles of the values for each of the given qs, from 0 to 1,
+// using the default method. See Quantile.
+func Quantiles(values []Decimal, qs ...Decimal) []Decimal {
+	quantiles := make([]Decimal, len(qs))
+	for i, q := range qs {
+		quantiles[i] = Quantile(values, q, QuantileDefault)
+	}
+
+	return quantiles
+}
+
+// IQR returns the interquartile range of a slice of decimals, the difference between its
+// third and first quartiles using the default quantile method.
+func IQR(decimals ...Decimal) Decimal {
+	quartiles := Quantiles(decimals, NewFromString("0.25"), NewFromString("0.75"))
+	return quartiles[1].Sub(quartiles[0])
+}
+
+// MAD returns the median absolute deviation of a slice of decimals, the median of the
+// absolute differences between the decimals and their median.
+func MAD(decimals ...Decimal) Decimal {
+	median := Median(decimals...)
+
+	deviations := make([]Decimal, len(decimals))
+	for i, decimal := range decimals {
+		deviations[i] = decimal.Sub(median).Abs()
+	}
+
+	return Median(deviations...)
+}
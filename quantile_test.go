@@ -0,0 +1,151 @@
+package big
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSort(t *testing.T) {
+	decimals := []Decimal{NewFromInt(3), NaN, NegInf(), NewFromString("-1.5"), PosInf(), NewFromInt(3), ZERO}
+	Sort(decimals)
+
+	assert.EqualValues(t, []string{"-Inf", "-1.5", "0", "3", "3", "+Inf", "NaN"}, decimalStrings(decimals))
+}
+
+func TestQuantile(t *testing.T) {
+	values := decimalsFromInts(7, 1, 3, 9, 15, 4, 12, 6, 10, 2)
+
+	// Computed from the definitions of Hyndman and Fan with exact rational arithmetic.
+	expected := map[string][]string{
+		"0":    {"1", "1", "1", "1", "1", "1", "1", "1", "1"},
+		"0.1":  {"1", "1.5", "1", "1", "1.5", "1.1", "1.9", "1.366666666666666666666666666666666666666666666666666666666666667", "1.4"},
+		"0.25": {"3", "3", "2", "2.5", "3", "2.75", "3.25", "2.916666666666666666666666666666666666666666666666666666666666667", "2.9375"},
+		"0.5":  {"6", "6.5", "6", "6", "6.5", "6.5", "6.5", "6.5", "6.5"},
+		"0.9":  {"12", "13.5", "12", "12", "13.5", "14.7", "12.3", "13.9", "13.8"},
+		"1":    {"15", "15", "15", "15", "15", "15", "15", "15", "15"},
+	}
+
+	for q, quantiles := range expected {
+		for i, quantile := range quantiles {
+			method := QuantileMethod(i + 1)
+
+			assertDigits(t, quantile, Quantile(values, NewFromString(q), method), 60)
+		}
+	}
+
+	validateEqExamples(t,
+		equalExample{
+			value:    Quantile(values, NewFromString("0.4"), QuantileDefault),
+			expected: "5.2",
+		},
+		equalExample{
+			value:    Quantile(decimalsFromInts(1, 2, 3, 4), NewFromString("0.5"), QuantileType3),
+			expected: "2",
+		},
+		equalExample{
+			value:    Quantile(decimalsFromInts(1, 2, 3, 4, 5, 6), NewFromString("0.5"), QuantileType3),
+			expected: "3",
+		},
+		equalExample{
+			value:    Quantile(decimalsFromInts(42), NewFromString("0.3"), QuantileType6),
+			expected: "42",
+		},
+		equalExample{
+			value:    Quantile(nil, NewFromString("0.5"), QuantileDefault),
+			expected: "0",
+		},
+		equalExample{
+			value:    Quantile([]Decimal{ONE, NaN}, NewFromString("0.5"), QuantileDefault),
+			expected: "NaN",
+		},
+	)
+
+	t.Run("domain", func(t *testing.T) {
+		for _, q := range []Decimal{NewFromString("-0.1"), NewFromString("1.1"), PosInf()} {
+			assert.True(t, errors.Is(Quantile(values, q, QuantileDefault).NaNReason(), ErrDomain), q.String())
+		}
+
+		assert.True(t, errors.Is(Quantile(values, ONE, QuantileType9+1).NaNReason(), ErrDomain))
+		assert.True(t, errors.Is(Quantile(values, ONE, -1).NaNReason(), ErrDomain))
+	})
+
+	t.Run("does not modify values", func(t *testing.T) {
+		Quantile(values, NewFromString("0.5"), QuantileDefault)
+
+		assert.EqualValues(t, "7", values[0].String())
+	})
+}
+
+func TestPercentile(t *testing.T) {
+	values := decimalsFromInts(1, 2, 3, 4, 5)
+
+	validateEqExamples(t,
+		equalExample{
+			value:    Percentile(values, NewFromInt(40), QuantileDefault),
+			expected: "2.6",
+		},
+		equalExample{
+			value:    Percentile(values, NewFromString("12.5"), QuantileType7),
+			expected: "1.5",
+		},
+		equalExample{
+			value:    Percentile(values, NewFromInt(100), QuantileType1),
+			expected: "5",
+		},
+		equalExample{
+			value:    Percentile(values, NewFromInt(101), QuantileDefault),
+			expected: "NaN",
+		},
+		equalExample{
+			value:    Percentile(values, NaN, QuantileDefault),
+			expected: "NaN",
+		},
+	)
+}
+
+func TestQuantiles(t *testing.T) {
+	quantiles := Quantiles(decimalsFromInts(1, 2, 3, 4, 5), NewFromString("0.25"), NewFromString("0.5"), NewFromString("0.75"))
+
+	assert.EqualValues(t, []string{"2", "3", "4"}, decimalStrings(quantiles))
+	assert.Empty(t, Quantiles(decimalsFromInts(1)))
+}
+
+func TestIQR(t *testing.T) {
+	validateEqExamples(t,
+		equalExample{
+			value:    IQR(decimalsFromInts(1, 2, 3, 4, 5, 6, 7, 8)...),
+			expected: "3.5",
+		},
+		equalExample{
+			value:    IQR(NaN, ONE),
+			expected: "NaN",
+		},
+		equalExample{
+			value:    IQR(),
+			expected: "0",
+		},
+	)
+}
+
+func TestMAD(t *testing.T) {
+	validateEqExamples(t,
+		equalExample{
+			value:    MAD(decimalsFromInts(1, 1, 2, 2, 4, 6, 9)...),
+			expected: "1",
+		},
+		equalExample{
+			value:    MAD(NewFromString("-1.5"), NewFromString("2.5")),
+			expected: "2",
+		},
+		equalExample{
+			value:    MAD(ONE, NaN),
+			expected: "NaN",
+		},
+		equalExample{
+			value:    MAD(),
+			expected: "0",
+		},
+	)
+}
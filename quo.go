@@ -0,0 +1,83 @@
+package big
+
+import "math/big"
+
+// QuoInt returns the quotient of this Decimal and the divisor, truncated to an integer.
+func (d Decimal) QuoInt(divisor Decimal) Decimal {
+	quo, _ := d.QuoRem(divisor)
+	return quo
+}
+
+// QuoRem returns the quotient of this Decimal and the divisor truncated to an integer,
+// and the remainder d - quo × divisor, which has the sign of this Decimal.
+//
+// Like Round, QuoRem operates on the shortest decimal representations of its operands,
+// so the results are exact: NewFromString("1").QuoRem(NewFromString("0.1")) is (10, 0).
+// Dividing by zero or dividing an infinity is NaN, and a finite value divided by an
+// infinity has a zero quotient and leaves the value as the remainder.
+func (d Decimal) QuoRem(divisor Decimal) (Decimal, Decimal) {
+	return d.quoRem("QuoRem", divisor, RoundDown)
+}
+
+// QuoMod returns the quotient of this Decimal and the divisor rounded toward negative
+// infinity, and the modulus d - quo × divisor, which has the sign of the divisor.
+func (d Decimal) QuoMod(divisor Decimal) (Decimal, Decimal) {
+	return d.quoRem("QuoMod", divisor, RoundFloor)
+}
+
+// Rem returns the remainder of dividing this Decimal by the divisor, which has the sign
+// of this Decimal. See QuoRem.
+func (d Decimal) Rem(divisor Decimal) Decimal {
+	_, rem := d.QuoRem(divisor)
+	return rem
+}
+
+// Mod returns the modulus of this Decimal and the divisor, which has the sign of the
+// divisor. See QuoMod.
+func (d Decimal) Mod(divisor Decimal) Decimal {
+	_, mod := d.QuoMod(divisor)
+	return mod
+}
+
+func (d Decimal) quoRem(op string, divisor Decimal, mode RoundingMode) (Decimal, Decimal) {
+	if nan, ok := firstNaN(d, divisor); ok {
+		return nan, nan
+	}
+
+	switch {
+	case divisor.IsZero():
+		nan := nanDecimal(ErrDivisionByZero, op, d, divisor)
+		return nan, nan
+	case d.IsInf(0):
+		nan := nanDecimal(ErrDomain, op, d, divisor)
+		return nan, nan
+	case divisor.IsInf(0):
+		if mode == RoundFloor && d.Sign() != 0 && d.Sign() != divisor.Sign() {
+			return NewFromInt(-1), divisor
+		}
+
+		return zeroDecimal(), d
+	}
+
+	dividendCoef, dividendExp := d.decimalParts()
+	divisorCoef, divisorExp := divisor.decimalParts()
+
+	// Scale both operands to integers with a common exponent.
+	exp := min(dividendExp, divisorExp)
+	dividendCoef.Mul(dividendCoef, pow10(dividendExp-exp))
+	divisorCoef.Mul(divisorCoef, pow10(divisorExp-exp))
+
+	if divisorCoef.Sign() < 0 {
+		dividendCoef.Neg(dividendCoef)
+		divisorCoef.Neg(divisorCoef)
+	}
+
+	quo := roundQuo(dividendCoef, divisorCoef, mode)
+	rem := new(big.Int).Sub(dividendCoef, new(big.Int).Mul(quo, divisorCoef))
+
+	if divisor.Sign() < 0 {
+		rem.Neg(rem)
+	}
+
+	return newFromScaled(quo, 0), newFromScaled(rem, -exp)
+}
@@ -0,0 +1,126 @@
+package big
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecimal_QuoRem(t *testing.T) {
+	examples := []struct {
+		dividend, divisor string
+		quo, rem          string
+	}{
+		{"10", "3", "3", "1"},
+		{"-10", "3", "-3", "-1"},
+		{"10", "-3", "-3", "1"},
+		{"-10", "-3", "3", "-1"},
+		{"1", "0.1", "10", "0"},
+		{"0.3", "0.1", "3", "0"},
+		{"7.5", "2", "3", "1.5"},
+		{"1234.5678", "0.025", "49382", "0.0178"},
+		{"0.05", "1", "0", "0.05"},
+		{"123456789012345678901234567890", "7", "17636684144620811271604938270", "0"},
+		{"1e30", "0.0000003", "3333333333333333333333333333333333333", "0.0000001"},
+	}
+
+	for _, ex := range examples {
+		quo, rem := NewFromString(ex.dividend).QuoRem(NewFromString(ex.divisor))
+
+		assert.EqualValues(t, ex.quo, quo.FormattedString(-1), "%s / %s", ex.dividend, ex.divisor)
+		assert.EqualValues(t, ex.rem, rem.FormattedString(-1), "%s %% %s", ex.dividend, ex.divisor)
+	}
+}
+
+func TestDecimal_QuoMod(t *testing.T) {
+	examples := []struct {
+		dividend, divisor string
+		quo, mod          string
+	}{
+		{"10", "3", "3", "1"},
+		{"-10", "3", "-4", "2"},
+		{"10", "-3", "-4", "-2"},
+		{"-10", "-3", "3", "-1"},
+		{"-1", "0.1", "-10", "0"},
+		{"-0.07", "0.05", "-2", "0.03"},
+	}
+
+	for _, ex := range examples {
+		quo, mod := NewFromString(ex.dividend).QuoMod(NewFromString(ex.divisor))
+
+		assert.EqualValues(t, ex.quo, quo.FormattedString(-1), "%s / %s", ex.dividend, ex.divisor)
+		assert.EqualValues(t, ex.mod, mod.FormattedString(-1), "%s mod %s", ex.dividend, ex.divisor)
+	}
+}
+
+func TestDecimal_QuoInt(t *testing.T) {
+	validateEqExamples(t,
+		equalExample{
+			value:    NewFromString("-7.9").QuoInt(NewFromInt(2)),
+			expected: "-3",
+		},
+		equalExample{
+			value:    NewFromString("100.5").QuoInt(NewFromString("0.25")),
+			expected: "402",
+		},
+	)
+}
+
+func TestDecimal_Rem(t *testing.T) {
+	validateEqExamples(t,
+		equalExample{
+			value:    NewFromString("-7.5").Rem(NewFromInt(2)),
+			expected: "-1.5",
+		},
+		equalExample{
+			value:    NewFromString("1.0").Rem(NewFromString("0.1")),
+			expected: "0",
+		},
+		equalExample{
+			value:    TEN.Rem(PosInf()),
+			expected: "10",
+		},
+		equalExample{
+			value:    TEN.Neg().Rem(PosInf()),
+			expected: "-10",
+		},
+	)
+}
+
+func TestDecimal_Mod(t *testing.T) {
+	validateEqExamples(t,
+		equalExample{
+			value:    NewFromString("-7.5").Mod(NewFromInt(2)),
+			expected: "0.5",
+		},
+		equalExample{
+			value:    NewFromString("7.5").Mod(NewFromInt(-2)),
+			expected: "-0.5",
+		},
+		equalExample{
+			value:    TEN.Mod(PosInf()),
+			expected: "10",
+		},
+		equalExample{
+			value:    TEN.Neg().Mod(PosInf()),
+			expected: "+Inf",
+		},
+	)
+}
+
+func TestDecimal_QuoRem_Invalid(t *testing.T) {
+	quo, rem := TEN.QuoRem(ZERO)
+
+	assert.True(t, quo.NaN())
+	assert.True(t, rem.NaN())
+	assert.True(t, errors.Is(rem.NaNReason(), ErrDivisionByZero))
+
+	quo, mod := PosInf().QuoMod(TEN)
+
+	assert.True(t, quo.NaN())
+	assert.True(t, errors.Is(mod.NaNReason(), ErrDomain))
+
+	assert.True(t, NaN.Rem(TEN).NaN())
+	assert.True(t, TEN.Mod(NaN).NaN())
+}
@@ -0,0 +1,338 @@
+package big
+
+import (
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// RoundingMode determines how a Decimal is rounded to a given number of decimal places.
+type RoundingMode int
+
+// The rounding modes supported by RoundMode. The zero value is RoundHalfEven.
+const (
+	// RoundHalfEven rounds to the nearest neighbor, and ties to the even neighbor.
+	RoundHalfEven RoundingMode = iota
+	// RoundHalfAwayFromZero rounds to the nearest neighbor, and ties away from zero.
+	RoundHalfAwayFromZero
+	// RoundHalfTowardZero rounds to the nearest neighbor, and ties toward zero.
+	RoundHalfTowardZero
+	// RoundHalfUp rounds to the nearest neighbor, and ties toward positive infinity.
+	RoundHalfUp
+	// RoundHalfDown rounds to the nearest neighbor, and ties toward negative infinity.
+	RoundHalfDown
+	// RoundUp rounds away from zero.
+	RoundUp
+	// RoundDown rounds toward zero.
+	RoundDown
+	// RoundCeiling rounds toward positive infinity.
+	RoundCeiling
+	// RoundFloor rounds toward negative infinity.
+	RoundFloor
+)
+
+// Round returns this Decimal rounded to the given number of decimal places, with ties
+// rounded away from zero. A negative number of places rounds to the left of the decimal
+// point.
+func (d Decimal) Round(places int) Decimal {
+	return d.RoundMode(places, RoundHalfAwayFromZero)
+}
+
+// RoundMode returns this Decimal rounded to the given number of decimal places using the
+// given rounding mode.
+//
+// Rounding operates on the shortest decimal representation that round-trips to this
+// Decimal at its precision, so NewFromString("2.675").Round(2) is 2.68, and the result is
+// the same value NewFromString produces for the rounded digits.
+func (d Decimal) RoundMode(places int, mode RoundingMode) Decimal {
+	if !d.finite() {
+		return d
+	}
+
+	// Values far smaller than a unit in the last place round to zero or to one unit,
+	// without the cost of finding the digits of a tiny value.
+	if !d.IsZero() && d.magnitudeBelow(-(places + 1)) {
+		if !roundsAway(d, mode) {
+			return zeroDecimal()
+		}
+
+		return withSign(placeUnit(places), d.Sign())
+	}
+
+	coef, exp := d.decimalParts()
+	if exp+places >= 0 {
+		return d
+	}
+
+	rounded := roundQuo(coef, pow10(-(places + exp)), mode)
+
+	return newFromScaled(rounded, places)
+}
+
+// Truncate returns this Decimal with all digits past the given number of decimal places
+// removed.
+func (d Decimal) Truncate(places int) Decimal {
+	return d.RoundMode(places, RoundDown)
+}
+
+// Floor returns this Decimal rounded toward negative infinity to the given number of
+// decimal places.
+func (d Decimal) Floor(places int) Decimal {
+	return d.RoundMode(places, RoundFloor)
+}
+
+// Ceil returns this Decimal rounded toward positive infinity to the given number of
+// decimal places.
+func (d Decimal) Ceil(places int) Decimal {
+	return d.RoundMode(places, RoundCeiling)
+}
+
+// RoundUp returns this Decimal rounded away from zero to the given number of decimal
+// places.
+func (d Decimal) RoundUp(places int) Decimal {
+	return d.RoundMode(places, RoundUp)
+}
+
+// RoundDown returns this Decimal rounded toward zero to the given number of decimal
+// places.
+func (d Decimal) RoundDown(places int) Decimal {
+	return d.RoundMode(places, RoundDown)
+}
+
+// RoundHalfUp returns this Decimal rounded to the given number of decimal places, with
+// ties rounded toward positive infinity.
+func (d Decimal) RoundHalfUp(places int) Decimal {
+	return d.RoundMode(places, RoundHalfUp)
+}
+
+// RoundHalfDown returns this Decimal rounded to the given number of decimal places, with
+// ties rounded toward negative infinity.
+func (d Decimal) RoundHalfDown(places int) Decimal {
+	return d.RoundMode(places, RoundHalfDown)
+}
+
+// RoundHalfEven returns this Decimal rounded to the given number of decimal places, with
+// ties rounded to the even neighbor.
+func (d Decimal) RoundHalfEven(places int) Decimal {
+	return d.RoundMode(places, RoundHalfEven)
+}
+
+// RoundHalfAwayFromZero returns this Decimal rounded to the given number of decimal
+// places, with ties rounded away from zero.
+func (d Decimal) RoundHalfAwayFromZero(places int) Decimal {
+	return d.RoundMode(places, RoundHalfAwayFromZero)
+}
+
+// RoundToIncrement returns this Decimal rounded to a multiple of the increment using the
+// given rounding mode, such as to 0.05 for cash rounding or to 0.03125 for prices quoted
+// in 32nds. The sign of the increment is ignored.
+//
+// Like RoundMode, RoundToIncrement operates on the shortest decimal representations of
+// this Decimal and the increment, so the result is exact. A zero, infinite or NaN
+// increment is NaN.
+func (d Decimal) RoundToIncrement(inc Decimal, mode RoundingMode) Decimal {
+	if nan, ok := firstNaN(d, inc); ok {
+		return nan
+	} else if !inc.finite() || inc.IsZero() {
+		return nanDecimal(ErrDomain, "RoundToIncrement", d, inc)
+	} else if !d.finite() {
+		return d
+	}
+
+	if !d.IsZero() && d.magnitudeBelow(inc.Exponent()-1) {
+		if !roundsAway(d, mode) {
+			return zeroDecimal()
+		}
+
+		return withSign(inc, d.Sign())
+	}
+
+	coef, exp := d.decimalParts()
+	incCoef, incExp := inc.decimalParts()
+
+	// Scale both to integers with a common exponent.
+	scale := min(exp, incExp)
+	coef.Mul(coef, pow10(exp-scale))
+	incCoef.Mul(incCoef.Abs(incCoef), pow10(incExp-scale))
+
+	multiple := roundQuo(coef, incCoef, mode)
+
+	return newFromScaled(multiple.Mul(multiple, incCoef), -scale)
+}
+
+// magnitudeBelow returns true if the magnitude of this finite Decimal is certainly less
+// than 10^exp, judging from its binary exponent alone, which is cheap to find even when
+// its decimal digits are not.
+func (d Decimal) magnitudeBelow(exp int) bool {
+	// |d| < 2^binaryExp, which is at most 10^exp when binaryExp ≤ exp × log2(10). The
+	// margin of one covers the rounding of the product.
+	return float64(d.value().MantExp(nil)) < float64(exp)*math.Log2(10)-1
+}
+
+// roundsAway returns true if rounding a nonzero value smaller in magnitude than half of
+// the rounding unit gives a unit rather than zero.
+func roundsAway(d Decimal, mode RoundingMode) bool {
+	switch mode {
+	case RoundUp:
+		return true
+	case RoundCeiling:
+		return d.Sign() > 0
+	case RoundFloor:
+		return d.Sign() < 0
+	}
+
+	return false
+}
+
+// withSign returns the magnitude of a non-NaN Decimal with the given sign, keeping its
+// precision.
+func withSign(d Decimal, sign int) Decimal {
+	magnitude := new(big.Float).Abs(d.value())
+	if sign < 0 {
+		magnitude.Neg(magnitude)
+	}
+
+	return Decimal{fl: magnitude}
+}
+
+// placeUnit returns 10^-places, or +Inf if that is too large to represent.
+func placeUnit(places int) Decimal {
+	text := strconv.Itoa(places)
+	if places < 0 {
+		text = text[1:]
+	} else {
+		text = "-" + text
+	}
+
+	unit, err := Parse("1e" + text)
+	if err != nil {
+		return PosInf()
+	}
+
+	return unit
+}
+
+// decimalParts returns the coefficient and exponent of the shortest decimal that
+// round-trips to this Decimal at its precision, such that d == coef × 10^exp.
+func (d Decimal) decimalParts() (*big.Int, int) {
+	text := d.value().Text('e', -1)
+
+	mantissa, exponent, _ := strings.Cut(text, "e")
+	exp, _ := strconv.Atoi(exponent)
+
+	if whole, frac, found := strings.Cut(mantissa, "."); found {
+		mantissa = whole + frac
+		exp -= len(frac)
+	}
+
+	coef, _ := new(big.Int).SetString(mantissa, 10)
+
+	return coef, exp
+}
+
+// newFromScaled returns a Decimal with the value coef × 10^-scale. The result is the
+// same value NewFromString produces for the plain decimal text of that number.
+func newFromScaled(coef *big.Int, scale int) Decimal {
+	return NewFromString(scaledText(coef, scale))
+}
+
+// scaledText returns the plain decimal text of coef × 10^-scale, without trailing
+// fractional zeros.
+func scaledText(coef *big.Int, scale int) string {
+	digits := new(big.Int).Abs(coef).String()
+	for scale > 0 && len(digits) > 1 && digits[len(digits)-1] == '0' {
+		digits = digits[:len(digits)-1]
+		scale--
+	}
+
+	if scale < 0 && digits != "0" {
+		digits += strings.Repeat("0", -scale)
+	} else if scale > 0 {
+		if len(digits) <= scale {
+			digits = strings.Repeat("0", scale-len(digits)+1) + digits
+		}
+
+		digits = digits[:len(digits)-scale] + "." + digits[len(digits)-scale:]
+	}
+
+	if coef.Sign() < 0 {
+		return "-" + digits
+	}
+
+	return digits
+}
+
+// roundSignificant returns coef × 10^exp rounded half away from zero to n significant
+// digits, as a new coefficient and exponent.
+func roundSignificant(coef *big.Int, exp, n int) (*big.Int, int) {
+	drop := len(new(big.Int).Abs(coef).String()) - n
+	if drop <= 0 || coef.Sign() == 0 {
+		return coef, exp
+	}
+
+	rounded := roundQuo(coef, pow10(drop), RoundHalfAwayFromZero)
+	exp += drop
+
+	// Rounding up may carry into a new digit, as 999 to two digits is 100 × 10^1.
+	if len(new(big.Int).Abs(rounded).String()) > n {
+		rounded.Quo(rounded, big.NewInt(10))
+		exp++
+	}
+
+	return rounded, exp
+}
+
+// roundQuo returns num / den rounded to an integer using the given rounding mode. The
+// denominator must be positive.
+func roundQuo(num, den *big.Int, mode RoundingMode) *big.Int {
+	quo, rem := new(big.Int).QuoRem(num, den, new(big.Int))
+	if rem.Sign() == 0 {
+		return quo
+	}
+
+	negative := num.Sign() < 0
+	var away bool
+
+	switch mode {
+	case RoundUp:
+		away = true
+	case RoundDown:
+		away = false
+	case RoundCeiling:
+		away = !negative
+	case RoundFloor:
+		away = negative
+	default:
+		switch cmp := rem.Abs(rem).Lsh(rem, 1).Cmp(den); {
+		case cmp > 0:
+			away = true
+		case cmp < 0:
+			away = false
+		case mode == RoundHalfEven:
+			away = quo.Bit(0) == 1
+		case mode == RoundHalfAwayFromZero:
+			away = true
+		case mode == RoundHalfTowardZero:
+			away = false
+		case mode == RoundHalfUp:
+			away = !negative
+		case mode == RoundHalfDown:
+			away = negative
+		}
+	}
+
+	if !away {
+		return quo
+	}
+
+	if negative {
+		return quo.Sub(quo, big.NewInt(1))
+	}
+
+	return quo.Add(quo, big.NewInt(1))
+}
+
+func pow10(exp int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(exp)), nil)
+}
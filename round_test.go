@@ -0,0 +1,193 @@
+package big
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecimal_RoundMode(t *testing.T) {
+	inputs := []string{"5.5", "2.5", "1.6", "1.1", "1.0", "-1.0", "-1.1", "-1.6", "-2.5", "-5.5"}
+
+	expected := map[RoundingMode][]string{
+		RoundHalfEven:         {"6", "2", "2", "1", "1", "-1", "-1", "-2", "-2", "-6"},
+		RoundHalfAwayFromZero: {"6", "3", "2", "1", "1", "-1", "-1", "-2", "-3", "-6"},
+		RoundHalfTowardZero:   {"5", "2", "2", "1", "1", "-1", "-1", "-2", "-2", "-5"},
+		RoundHalfUp:           {"6", "3", "2", "1", "1", "-1", "-1", "-2", "-2", "-5"},
+		RoundHalfDown:         {"5", "2", "2", "1", "1", "-1", "-1", "-2", "-3", "-6"},
+		RoundUp:               {"6", "3", "2", "2", "1", "-1", "-2", "-2", "-3", "-6"},
+		RoundDown:             {"5", "2", "1", "1", "1", "-1", "-1", "-1", "-2", "-5"},
+		RoundCeiling:          {"6", "3", "2", "2", "1", "-1", "-1", "-1", "-2", "-5"},
+		RoundFloor:            {"5", "2", "1", "1", "1", "-1", "-2", "-2", "-3", "-6"},
+	}
+
+	for mode, results := range expected {
+		for i, input := range inputs {
+			assert.EqualValues(t, results[i], NewFromString(input).RoundMode(0, mode).String(), "mode %d, input %s", mode, input)
+		}
+	}
+}
+
+func TestDecimal_Round(t *testing.T) {
+	validateEqExamples(t,
+		equalExample{
+			value:    NewFromString("2.675").Round(2),
+			expected: "2.68",
+		},
+		equalExample{
+			value:    NewDecimal(2.675).Round(2),
+			expected: "2.67",
+		},
+		equalExample{
+			value:    NewFromString("-2.675").Round(2),
+			expected: "-2.68",
+		},
+		equalExample{
+			value:    NewFromString("1234.5678").Round(-2),
+			expected: "1200",
+		},
+		equalExample{
+			value:    NewFromString("1.2").Round(4),
+			expected: "1.2",
+		},
+		equalExample{
+			value:    NaN.Round(2),
+			expected: "NaN",
+		},
+	)
+
+	t.Run("result is the decimal value", func(t *testing.T) {
+		assert.True(t, NewDecimal(0.1).Add(NewDecimal(0.2)).Round(2).EQ(NewFromString("0.3")))
+		assert.True(t, NewFromString("123456789012345678901234567890.125").Round(2).EQ(NewFromString("123456789012345678901234567890.13")))
+	})
+}
+
+func TestDecimal_RoundMode_Tiny(t *testing.T) {
+	tiny, negativeTiny := NewFromString("1e-100000"), NewFromString("-1e-100000")
+
+	expected := map[RoundingMode][2]string{
+		RoundHalfEven: {"0", "0"},
+		RoundUp:       {"0.01", "-0.01"},
+		RoundDown:     {"0", "0"},
+		RoundCeiling:  {"0.01", "0"},
+		RoundFloor:    {"0", "-0.01"},
+	}
+
+	for mode, results := range expected {
+		assert.EqualValues(t, results[0], tiny.RoundMode(2, mode).String(), "mode %d", mode)
+		assert.EqualValues(t, results[1], negativeTiny.RoundMode(2, mode).String(), "mode %d", mode)
+	}
+
+	assert.EqualValues(t, "1e+30", tiny.RoundUp(-30).String())
+	assert.EqualValues(t, "0", tiny.RoundToIncrement(NewFromString("0.05"), RoundHalfEven).String())
+	assert.EqualValues(t, "-0.05", negativeTiny.RoundToIncrement(NewFromString("-0.05"), RoundFloor).String())
+	assert.True(t, tiny.FracPart().EQ(tiny))
+	assert.True(t, NewFromString("0.001").Round(2).IsZero())
+	assert.EqualValues(t, "0.01", NewFromString("0.001").RoundUp(2).String())
+
+	t.Run("extreme places", func(t *testing.T) {
+		assert.EqualValues(t, "0", NewFromString("123.45").Round(math.MinInt).String())
+		assert.EqualValues(t, "+Inf", NewFromString("123.45").RoundUp(math.MinInt).String())
+		assert.EqualValues(t, "-Inf", NewFromString("-123.45").Floor(math.MinInt).String())
+		assert.EqualValues(t, "123.45", NewFromString("123.45").Round(math.MaxInt).String())
+	})
+}
+
+func TestDecimal_RoundingShortcuts(t *testing.T) {
+	d := NewFromString("-3.14159")
+
+	validateEqExamples(t,
+		equalExample{
+			value:    d.Truncate(3),
+			expected: "-3.141",
+		},
+		equalExample{
+			value:    d.Floor(3),
+			expected: "-3.142",
+		},
+		equalExample{
+			value:    d.Ceil(3),
+			expected: "-3.141",
+		},
+		equalExample{
+			value:    d.RoundUp(1),
+			expected: "-3.2",
+		},
+		equalExample{
+			value:    d.RoundDown(1),
+			expected: "-3.1",
+		},
+		equalExample{
+			value:    NewFromString("0.125").RoundHalfUp(2),
+			expected: "0.13",
+		},
+		equalExample{
+			value:    NewFromString("0.125").RoundHalfDown(2),
+			expected: "0.12",
+		},
+		equalExample{
+			value:    NewFromString("0.125").RoundHalfEven(2),
+			expected: "0.12",
+		},
+		equalExample{
+			value:    NewFromString("-0.125").RoundHalfAwayFromZero(2),
+			expected: "-0.13",
+		},
+	)
+}
+
+func TestDecimal_RoundToIncrement(t *testing.T) {
+	examples := []struct {
+		value, inc string
+		mode       RoundingMode
+		expected   string
+	}{
+		{"1.02", "0.05", RoundHalfEven, "1"},
+		{"1.025", "0.05", RoundHalfEven, "1"},
+		{"1.025", "0.05", RoundHalfAwayFromZero, "1.05"},
+		{"1.075", "0.05", RoundHalfEven, "1.1"},
+		{"1.03", "0.05", RoundHalfEven, "1.05"},
+		{"-1.03", "0.05", RoundHalfEven, "-1.05"},
+		{"-1.025", "0.05", RoundHalfUp, "-1"},
+		{"-1.025", "0.05", RoundHalfDown, "-1.05"},
+		{"1.01", "0.05", RoundUp, "1.05"},
+		{"1.04", "0.05", RoundDown, "1"},
+		{"-1.01", "0.05", RoundFloor, "-1.05"},
+		{"-1.04", "0.05", RoundCeiling, "-1"},
+		{"101.3", "0.25", RoundHalfEven, "101.25"},
+		{"101.375", "0.25", RoundHalfEven, "101.5"},
+		{"99.515625", "0.03125", RoundHalfEven, "99.5"},
+		{"99.53125", "0.03125", RoundHalfEven, "99.53125"},
+		{"99.546875", "0.03125", RoundHalfEven, "99.5625"},
+		{"1234", "50", RoundHalfEven, "1250"},
+		{"1275", "50", RoundHalfEven, "1300"},
+		{"1.03", "-0.05", RoundHalfEven, "1.05"},
+		{"0.0000001", "0.05", RoundHalfEven, "0"},
+		{"12345678901234567890.123", "0.1", RoundHalfEven, "12345678901234567890.1"},
+	}
+
+	for _, ex := range examples {
+		rounded := NewFromString(ex.value).RoundToIncrement(NewFromString(ex.inc), ex.mode)
+
+		assert.EqualValues(t, ex.expected, rounded.String(), "%s to %s", ex.value, ex.inc)
+	}
+
+	validateEqExamples(t,
+		equalExample{
+			value:    PosInf().RoundToIncrement(NewFromString("0.05"), RoundHalfEven),
+			expected: "+Inf",
+		},
+		equalExample{
+			value:    NaN.RoundToIncrement(NewFromString("0.05"), RoundHalfEven),
+			expected: "NaN",
+		},
+	)
+
+	for _, inc := range []Decimal{ZERO, PosInf(), NaN} {
+		assert.True(t, ONE.RoundToIncrement(inc, RoundHalfEven).NaN(), inc.String())
+	}
+
+	assert.True(t, errors.Is(ONE.RoundToIncrement(ZERO, RoundHalfEven).NaNReason(), ErrDomain))
+}
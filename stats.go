@@ -0,0 +1,221 @@
+package big
+
+import (
+	"math"
+	"math/big"
+	"math/bits"
+)
+
+// Sum returns the exact sum of a slice of decimals. Like MaxSlice, it returns the first
+// NaN in the slice if there is one, and 0 for an empty slice. The sum of opposite
+// infinities is NaN.
+func Sum(decimals ...Decimal) Decimal {
+	if nan, ok := firstNaN(decimals...); ok {
+		return nan
+	} else if len(decimals) == 0 {
+		return zeroDecimal()
+	}
+
+	if inf, ok := infiniteSum("Sum", decimals); ok {
+		return inf
+	}
+
+	values := make([]*big.Float, len(decimals))
+	for i, decimal := range decimals {
+		values[i] = decimal.value()
+	}
+
+	return Decimal{fl: exactSum(values)}
+}
+
+// Mean returns the arithmetic mean of a slice of decimals, or 0 for an empty slice.
+func Mean(decimals ...Decimal) Decimal {
+	if len(decimals) == 0 {
+		return zeroDecimal()
+	}
+
+	return DefaultContext.Div(Sum(decimals...), NewFromInt(len(decimals)))
+}
+
+// Median returns the middle value of a slice of decimals, or the mean of the two middle
+// values if the slice has an even length. It returns 0 for an empty slice.
+func Median(decimals ...Decimal) Decimal {
+	if nan, ok := firstNaN(decimals...); ok {
+		return nan
+	} else if len(decimals) == 0 {
+		return zeroDecimal()
+	}
+
+	sorted := sortedCopy(decimals)
+	middle := len(sorted) / 2
+
+	if len(sorted)%2 == 1 {
+		return sorted[middle]
+	}
+
+	return Mean(sorted[middle-1], sorted[middle])
+}
+
+// Mode returns the most frequent value in a slice of decimals, and the smallest such
+// value if several are equally frequent. It returns 0 for an empty slice.
+func Mode(decimals ...Decimal) Decimal {
+	if nan, ok := firstNaN(decimals...); ok {
+		return nan
+	} else if len(decimals) == 0 {
+		return zeroDecimal()
+	}
+
+	sorted := sortedCopy(decimals)
+
+	mode, modeCount := sorted[0], 0
+	for start := 0; start < len(sorted); {
+		end := start + 1
+		for end < len(sorted) && sorted[end].Cmp(sorted[start]) == 0 {
+			end++
+		}
+
+		if end-start > modeCount {
+			mode, modeCount = sorted[start], end-start
+		}
+
+		start = end
+	}
+
+	return mode
+}
+
+// Product returns the exact product of a slice of decimals, or 0 for an empty slice.
+func Product(decimals ...Decimal) Decimal {
+	if nan, ok := firstNaN(decimals...); ok {
+		return nan
+	} else if len(decimals) == 0 {
+		return zeroDecimal()
+	}
+
+	product := decimals[0]
+	for _, decimal := range decimals[1:] {
+		product = DefaultContext.Mul(product, decimal)
+	}
+
+	return product
+}
+
+// WeightedMean returns the mean of the values weighted by the corresponding weights,
+// Σ(wᵢ × vᵢ) / Σwᵢ. It returns 0 for empty slices, and NaN if the slices have different
+// lengths.
+func WeightedMean(values, weights []Decimal) Decimal {
+	if len(values) != len(weights) {
+		return nanDecimal(ErrDomain, "WeightedMean", append(append([]Decimal{}, values...), weights...)...)
+	} else if nan, ok := firstNaN(append(append([]Decimal{}, values...), weights...)...); ok {
+		return nan
+	} else if len(values) == 0 {
+		return zeroDecimal()
+	}
+
+	products := make([]Decimal, len(values))
+	for i, value := range values {
+		products[i] = DefaultContext.Mul(value, weights[i])
+	}
+
+	return DefaultContext.Div(Sum(products...), Sum(weights...))
+}
+
+// GeometricMean returns the geometric mean of a slice of non-negative decimals, the nth
+// root of their product. It is 0 if any value is 0, NaN if any value is negative, and 0
+// for an empty slice.
+func GeometricMean(decimals ...Decimal) Decimal {
+	if nan, ok := firstNaN(decimals...); ok {
+		return nan
+	} else if len(decimals) == 0 {
+		return zeroDecimal()
+	}
+
+	var zero, inf bool
+	for _, decimal := range decimals {
+		switch {
+		case decimal.Sign() < 0:
+			return nanDecimal(ErrDomain, "GeometricMean", decimals...)
+		case decimal.IsZero():
+			zero = true
+		case decimal.IsInf(1):
+			inf = true
+		}
+	}
+
+	switch {
+	case zero && inf:
+		return nanDecimal(ErrIndeterminate, "GeometricMean", decimals...)
+	case zero:
+		return zeroDecimal()
+	case inf:
+		return PosInf()
+	}
+
+	z := DefaultContext.newFloat(maxPrecision(decimals...))
+	wp := z.Prec() + guardPrecision
+
+	// The geometric mean is e^(Σ ln xᵢ / n), summed exactly at the working precision.
+	logs := make([]*big.Float, len(decimals))
+	for i, decimal := range decimals {
+		logs[i] = lnFloat(decimal.value(), wp)
+	}
+
+	mean := newFloatPrec(wp).Quo(exactSum(logs), newFloatPrec(wp).SetInt64(int64(len(decimals))))
+
+	return Decimal{fl: z.Set(expFloat(mean, wp))}
+}
+
+// infiniteSum returns the sum of the decimals if any of them is infinite.
+func infiniteSum(op string, decimals []Decimal) (Decimal, bool) {
+	var positive, negative bool
+	for _, decimal := range decimals {
+		positive = positive || decimal.IsInf(1)
+		negative = negative || decimal.IsInf(-1)
+	}
+
+	switch {
+	case positive && negative:
+		return nanDecimal(ErrIndeterminate, op, decimals...), true
+	case positive:
+		return PosInf(), true
+	case negative:
+		return NegInf(), true
+	}
+
+	return Decimal{}, false
+}
+
+// exactSum returns the exact sum of finite values, at the smallest precision of at least
+// minPrecision that holds it.
+func exactSum(values []*big.Float) *big.Float {
+	// Every value is a multiple of 2^low and less than 2^high in magnitude, so their sum
+	// fits in high - low bits, plus one bit for every doubling of the number of values.
+	high, low := math.MinInt, math.MaxInt
+	for _, value := range values {
+		if value.Sign() != 0 {
+			exp := value.MantExp(nil)
+			high = max(high, exp)
+			low = min(low, exp-int(value.MinPrec()))
+		}
+	}
+
+	precision := minPrecision
+	if high > low {
+		precision = max(precision, uint(high-low)+uint(bits.Len(uint(len(values))))+1)
+	}
+
+	sum := newFloat(precision)
+	for _, value := range values {
+		sum.Add(sum, value)
+	}
+
+	return sum.SetPrec(max(sum.MinPrec(), minPrecision))
+}
+
+// sortedCopy returns the decimals sorted in ascending order, without modifying them.
+func sortedCopy(decimals []Decimal) []Decimal {
+	sorted := append([]Decimal{}, decimals...)
+	Sort(sorted)
+
+	return sorted
+}
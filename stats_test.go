@@ -0,0 +1,206 @@
+package big
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSum(t *testing.T) {
+	validateEqExamples(t,
+		equalExample{
+			value:    Sum(NewFromInt(1), NewFromInt(2), NewFromString("3.5")),
+			expected: "6.5",
+		},
+		equalExample{
+			value:    Sum(NewFromString("1e300"), NewFromString("1"), NewFromString("-1e300")),
+			expected: "1",
+		},
+		equalExample{
+			value:    Sum(NewDecimal(1e20), NewDecimal(1), NewDecimal(-1e20)),
+			expected: "1",
+		},
+		equalExample{
+			value:    Sum(ONE, PosInf()),
+			expected: "+Inf",
+		},
+		equalExample{
+			value:    Sum(ONE, NaN),
+			expected: "NaN",
+		},
+		equalExample{
+			value:    Sum(),
+			expected: "0",
+		},
+	)
+
+	assertDigits(t, "0.3", Sum(NewFromString("0.1"), NewFromString("0.2")), 70)
+	assert.True(t, errors.Is(Sum(PosInf(), NegInf()).NaNReason(), ErrIndeterminate))
+
+	t.Run("exact with many terms", func(t *testing.T) {
+		values := make([]Decimal, 0, 2001)
+		values = append(values, NewFromString("1e50"))
+		for i := 0; i < 1000; i++ {
+			values = append(values, NewFromString("1e-50"), NewFromString("-1e-50"))
+		}
+		values = append(values, NewFromString("-1e50"), NewFromString("0.5"))
+
+		assert.EqualValues(t, "0.5", Sum(values...).String())
+	})
+}
+
+func TestMean(t *testing.T) {
+	validateEqExamples(t,
+		equalExample{
+			value:    Mean(NewFromInt(1), NewFromInt(2), NewFromInt(3), NewFromInt(4)),
+			expected: "2.5",
+		},
+		equalExample{
+			value:    Mean(NewFromString("-7.25")),
+			expected: "-7.25",
+		},
+		equalExample{
+			value:    Mean(NewFromInt(1), NaN),
+			expected: "NaN",
+		},
+		equalExample{
+			value:    Mean(),
+			expected: "0",
+		},
+	)
+
+	assertDigits(t, "0.3333333333333333333333333333333333333333333333333333333333333333333333333", Mean(ONE, ZERO, ZERO), 70)
+}
+
+func TestMedian(t *testing.T) {
+	validateEqExamples(t,
+		equalExample{
+			value:    Median(NewFromInt(5), NewFromInt(1), NewFromInt(3)),
+			expected: "3",
+		},
+		equalExample{
+			value:    Median(NewFromInt(4), NewFromInt(1), NewFromInt(3), NewFromInt(2)),
+			expected: "2.5",
+		},
+		equalExample{
+			value:    Median(NegInf(), ONE, PosInf()),
+			expected: "1",
+		},
+		equalExample{
+			value:    Median(NewFromInt(1), NaN),
+			expected: "NaN",
+		},
+		equalExample{
+			value:    Median(),
+			expected: "0",
+		},
+	)
+}
+
+func TestMode(t *testing.T) {
+	validateEqExamples(t,
+		equalExample{
+			value:    Mode(NewFromInt(1), NewFromInt(2), NewFromInt(2), NewFromInt(3)),
+			expected: "2",
+		},
+		equalExample{
+			value:    Mode(NewFromInt(3), NewFromInt(3), NewFromInt(1), NewFromInt(1), NewFromInt(2)),
+			expected: "1",
+		},
+		equalExample{
+			value:    Mode(NewFromString("1.50"), NewFromString("1.5"), NewFromInt(7)),
+			expected: "1.5",
+		},
+		equalExample{
+			value:    Mode(NewFromInt(9)),
+			expected: "9",
+		},
+		equalExample{
+			value:    Mode(NaN),
+			expected: "NaN",
+		},
+		equalExample{
+			value:    Mode(),
+			expected: "0",
+		},
+	)
+}
+
+func TestProduct(t *testing.T) {
+	validateEqExamples(t,
+		equalExample{
+			value:    Product(NewFromInt(2), NewFromString("3.5"), NewFromInt(-4)),
+			expected: "-28",
+		},
+		equalExample{
+			value:    Product(NewFromString("123456789012345678901234567890"), NewFromString("987654321098765432109876543210")),
+			expected: "1.219326311370217952261850327336229233322374638011112635269e+59",
+		},
+		equalExample{
+			value:    Product(ZERO, PosInf()),
+			expected: "NaN",
+		},
+		equalExample{
+			value:    Product(),
+			expected: "0",
+		},
+	)
+}
+
+func TestWeightedMean(t *testing.T) {
+	validateEqExamples(t,
+		equalExample{
+			value:    WeightedMean([]Decimal{NewFromInt(10), NewFromInt(20)}, []Decimal{NewFromInt(3), NewFromInt(1)}),
+			expected: "12.5",
+		},
+		equalExample{
+			value:    WeightedMean([]Decimal{NewFromInt(10)}, []Decimal{NewFromInt(1), NewFromInt(2)}),
+			expected: "NaN",
+		},
+		equalExample{
+			value:    WeightedMean([]Decimal{NewFromInt(10), NaN}, []Decimal{ONE, ONE}),
+			expected: "NaN",
+		},
+		equalExample{
+			value:    WeightedMean(nil, nil),
+			expected: "0",
+		},
+	)
+
+	assert.True(t, errors.Is(WeightedMean([]Decimal{ONE}, nil).NaNReason(), ErrDomain))
+	assert.True(t, errors.Is(WeightedMean([]Decimal{ZERO}, []Decimal{ZERO}).NaNReason(), ErrDivisionByZero))
+}
+
+func TestGeometricMean(t *testing.T) {
+	validateEqExamples(t,
+		equalExample{
+			value:    GeometricMean(NewFromInt(2), NewFromInt(8)),
+			expected: "4",
+		},
+		equalExample{
+			value:    GeometricMean(NewFromInt(1), NewFromInt(3), NewFromInt(9)),
+			expected: "3",
+		},
+		equalExample{
+			value:    GeometricMean(NewFromInt(5), ZERO),
+			expected: "0",
+		},
+		equalExample{
+			value:    GeometricMean(NewFromInt(5), PosInf()),
+			expected: "+Inf",
+		},
+		equalExample{
+			value:    GeometricMean(NewFromInt(-1), NewFromInt(4)),
+			expected: "NaN",
+		},
+		equalExample{
+			value:    GeometricMean(),
+			expected: "0",
+		},
+	)
+
+	assertDigits(t, "1.817120592832139658891211756327260502428210463141219671481334297931309739", GeometricMean(NewFromInt(1), NewFromInt(2), NewFromInt(3)), 70)
+	assert.True(t, errors.Is(GeometricMean(NewFromInt(-1)).NaNReason(), ErrDomain))
+	assert.True(t, errors.Is(GeometricMean(ZERO, PosInf()).NaNReason(), ErrIndeterminate))
+}
@@ -0,0 +1,445 @@
+package big
+
+import (
+	"math/big"
+)
+
+var piConstant = &constant{compute: func(prec uint) *big.Float {
+	// π = 16 atan(1/5) - 4 atan(1/239)
+	one := newFloatPrec(prec).SetInt64(1)
+
+	fifth := atanSeries(newFloatPrec(prec).Quo(one, newFloatPrec(prec).SetInt64(5)), prec)
+	fifth.SetMantExp(fifth, 4)
+
+	inverse239 := atanSeries(newFloatPrec(prec).Quo(one, newFloatPrec(prec).SetInt64(239)), prec)
+	inverse239.SetMantExp(inverse239, 2)
+
+	return fifth.Sub(fifth, inverse239)
+}}
+
+// Pi returns π rounded to the given number of bits of precision, or to the default
+// precision of 256 bits if precision is zero.
+func Pi(precision uint) Decimal {
+	if precision == 0 {
+		precision = minPrecision
+	}
+
+	return Decimal{fl: piConstant.get(precision)}
+}
+
+// Sin returns the sine of the radian argument x. It is NaN for infinite arguments.
+func (c Context) Sin(x Decimal) Decimal {
+	return c.sinCos("Sin", x, func(sin, _ *big.Float) *big.Float {
+		return sin
+	})
+}
+
+// Cos returns the cosine of the radian argument x. It is NaN for infinite arguments.
+func (c Context) Cos(x Decimal) Decimal {
+	return c.sinCos("Cos", x, func(_, cos *big.Float) *big.Float {
+		return cos
+	})
+}
+
+// Tan returns the tangent of the radian argument x. It is NaN for infinite arguments.
+func (c Context) Tan(x Decimal) Decimal {
+	return c.sinCos("Tan", x, func(sin, cos *big.Float) *big.Float {
+		return sin.Quo(sin, cos)
+	})
+}
+
+// Asin returns the arcsine, in radians, of x. It is NaN when |x| > 1.
+func (c Context) Asin(x Decimal) Decimal {
+	return nanGuard(func() Decimal {
+		if x.Abs().GT(oneDecimal()) {
+			return nanDecimal(ErrDomain, "Asin", x)
+		} else if x.IsZero() {
+			return x
+		}
+
+		z := c.newFloat(x.value().Prec())
+		wp := z.Prec() + guardPrecision
+
+		if x.Abs().EQ(oneDecimal()) {
+			halfPi := piConstant.get(wp)
+			halfPi.SetMantExp(halfPi, -1)
+
+			return Decimal{fl: z.Mul(halfPi, x.value())}
+		}
+
+		// asin x = atan(x / √((1 - x)(1 + x)))
+		one := newFloatPrec(wp).SetInt64(1)
+		cosine := newFloatPrec(wp).Sub(one, x.value())
+		cosine.Mul(cosine, newFloatPrec(wp).Add(one, x.value()))
+		cosine.Sqrt(cosine)
+
+		return Decimal{fl: z.Set(atanFloat(cosine.Quo(x.value(), cosine), wp))}
+	}, x)
+}
+
+// Acos returns the arccosine, in radians, of x. It is NaN when |x| > 1.
+func (c Context) Acos(x Decimal) Decimal {
+	return nanGuard(func() Decimal {
+		if x.Abs().GT(oneDecimal()) {
+			return nanDecimal(ErrDomain, "Acos", x)
+		} else if x.EQ(oneDecimal()) {
+			return zeroDecimal()
+		}
+
+		z := c.newFloat(x.value().Prec())
+		wp := z.Prec() + guardPrecision
+
+		if x.EQ(oneDecimal().Neg()) {
+			return Decimal{fl: z.Set(piConstant.get(wp))}
+		}
+
+		// acos x = 2 atan(√((1 - x) / (1 + x)))
+		one := newFloatPrec(wp).SetInt64(1)
+		ratio := newFloatPrec(wp).Sub(one, x.value())
+		ratio.Quo(ratio, newFloatPrec(wp).Add(one, x.value()))
+
+		result := atanFloat(ratio.Sqrt(ratio), wp)
+		return Decimal{fl: z.Set(result.SetMantExp(result, 1))}
+	}, x)
+}
+
+// Atan returns the arctangent, in radians, of x.
+func (c Context) Atan(x Decimal) Decimal {
+	return nanGuard(func() Decimal {
+		if x.IsZero() {
+			return x
+		}
+
+		z := c.newFloat(x.value().Prec())
+		wp := z.Prec() + guardPrecision
+
+		if x.IsInf(0) {
+			halfPi := piConstant.get(wp)
+			halfPi.SetMantExp(halfPi, -1)
+
+			if x.Sign() < 0 {
+				halfPi.Neg(halfPi)
+			}
+
+			return Decimal{fl: z.Set(halfPi)}
+		}
+
+		return Decimal{fl: z.Set(atanFloat(x.value(), wp))}
+	}, x)
+}
+
+// Atan2 returns the arctangent, in radians, of y/x, using the signs of the two to
+// determine the quadrant of the result. Special cases follow IEEE-754 atan2.
+func (c Context) Atan2(y, x Decimal) Decimal {
+	return nanGuard(func() Decimal {
+		z := c.newFloat(max(y.value().Prec(), x.value().Prec()))
+		wp := z.Prec() + guardPrecision
+
+		pi := piConstant.get(wp)
+		result := newFloatPrec(wp)
+
+		switch {
+		case y.IsInf(0) && x.IsInf(0):
+			// ±π/4 or ±3π/4
+			result.SetInt64(1)
+			if x.Sign() < 0 {
+				result.SetInt64(3)
+			}
+			result.Mul(result, pi)
+			result.SetMantExp(result, -2)
+		case y.IsInf(0) || (x.IsZero() && !y.IsZero()):
+			result.SetMantExp(pi, -1)
+		case x.IsInf(1) || (x.Sign() > 0 && y.IsZero()) || (x.IsZero() && !x.value().Signbit()):
+			result.SetInt64(0)
+		case x.IsInf(-1) || y.IsZero():
+			result.Set(pi)
+		default:
+			quotient := newFloatPrec(wp).Quo(new(big.Float).Abs(y.value()), x.value())
+			result = atanFloat(quotient, wp)
+			if x.Sign() < 0 {
+				result.Add(result, pi)
+			}
+		}
+
+		if y.value().Signbit() {
+			result.Neg(result)
+		}
+
+		return Decimal{fl: z.Set(result)}
+	}, y, x)
+}
+
+// Sinh returns the hyperbolic sine of x.
+func (c Context) Sinh(x Decimal) Decimal {
+	return c.hyperbolic(x, x, x, func(exp, inverse *big.Float) *big.Float {
+		exp.Sub(exp, inverse)
+		return exp.SetMantExp(exp, -1)
+	})
+}
+
+// Cosh returns the hyperbolic cosine of x.
+func (c Context) Cosh(x Decimal) Decimal {
+	return c.hyperbolic(x, PosInf(), oneDecimal(), func(exp, inverse *big.Float) *big.Float {
+		exp.Add(exp, inverse)
+		return exp.SetMantExp(exp, -1)
+	})
+}
+
+// Tanh returns the hyperbolic tangent of x.
+func (c Context) Tanh(x Decimal) Decimal {
+	// Beyond 2^30, e^-2x no longer affects the result at any precision a Decimal could
+	// be asked for, and e^x would needlessly approach overflow.
+	if x.Abs().GT(NewFromInt(1 << 30)) {
+		return NewFromInt(x.Sign())
+	}
+
+	return c.hyperbolic(x, NewFromInt(x.Sign()), x, func(exp, inverse *big.Float) *big.Float {
+		sum := new(big.Float).SetPrec(exp.Prec()).Add(exp, inverse)
+		exp.Sub(exp, inverse)
+		return exp.Quo(exp, sum)
+	})
+}
+
+// Sin returns the sine of this Decimal, in radians.
+func (d Decimal) Sin() Decimal {
+	return DefaultContext.Sin(d)
+}
+
+// Cos returns the cosine of this Decimal, in radians.
+func (d Decimal) Cos() Decimal {
+	return DefaultContext.Cos(d)
+}
+
+// Tan returns the tangent of this Decimal, in radians.
+func (d Decimal) Tan() Decimal {
+	return DefaultContext.Tan(d)
+}
+
+// Asin returns the arcsine, in radians, of this Decimal.
+func (d Decimal) Asin() Decimal {
+	return DefaultContext.Asin(d)
+}
+
+// Acos returns the arccosine, in radians, of this Decimal.
+func (d Decimal) Acos() Decimal {
+	return DefaultContext.Acos(d)
+}
+
+// Atan returns the arctangent, in radians, of this Decimal.
+func (d Decimal) Atan() Decimal {
+	return DefaultContext.Atan(d)
+}
+
+// Atan2 returns the arctangent, in radians, of this Decimal divided by x, using the signs
+// of the two to determine the quadrant of the result.
+func (d Decimal) Atan2(x Decimal) Decimal {
+	return DefaultContext.Atan2(d, x)
+}
+
+// Sinh returns the hyperbolic sine of this Decimal.
+func (d Decimal) Sinh() Decimal {
+	return DefaultContext.Sinh(d)
+}
+
+// Cosh returns the hyperbolic cosine of this Decimal.
+func (d Decimal) Cosh() Decimal {
+	return DefaultContext.Cosh(d)
+}
+
+// Tanh returns the hyperbolic tangent of this Decimal.
+func (d Decimal) Tanh() Decimal {
+	return DefaultContext.Tanh(d)
+}
+
+// sinCos computes a function of the sine and cosine of x, which is NaN for infinities.
+func (c Context) sinCos(op string, x Decimal, fn func(sin, cos *big.Float) *big.Float) Decimal {
+	return nanGuard(func() Decimal {
+		if x.IsInf(0) {
+			return nanDecimal(ErrDomain, op, x)
+		}
+
+		z := c.newFloat(x.value().Prec())
+		wp := z.Prec() + guardPrecision
+
+		// With x = k π/2 + r, the quadrant k mod 4 selects the signs and order of the
+		// sine and cosine of r.
+		r, quadrant := reduceHalfPi(x.value(), wp)
+		sin, cos := sinCosSeries(r, wp)
+
+		switch quadrant {
+		case 1:
+			sin, cos = cos, sin.Neg(sin)
+		case 2:
+			sin, cos = sin.Neg(sin), cos.Neg(cos)
+		case 3:
+			sin, cos = cos.Neg(cos), sin
+		}
+
+		return Decimal{fl: z.Set(fn(sin, cos))}
+	}, x)
+}
+
+// hyperbolic computes a function of e^x and e^-x for finite x, or returns the given
+// limit for infinite x. The exponentials carry enough extra precision to absorb the
+// cancellation between them for small x. For zero and x so small that x² is lost at the
+// result's precision, it returns the given small value, x or 1, to which the function's
+// Taylor series is then equal.
+func (c Context) hyperbolic(x, limit, small Decimal, fn func(exp, inverse *big.Float) *big.Float) Decimal {
+	return nanGuard(func() Decimal {
+		if x.IsInf(0) {
+			return limit
+		}
+
+		z := c.newFloat(x.value().Prec())
+		magnitude := x.value().MantExp(nil)
+		if x.IsZero() || magnitude < -int(z.Prec()/2)-1 {
+			return Decimal{fl: z.Set(small.value())}
+		}
+
+		wp := z.Prec() + guardPrecision
+		if magnitude < 0 {
+			wp += uint(-magnitude)
+		}
+
+		exp := expFloat(x.value(), wp)
+		inverse := newFloatPrec(wp).SetInt64(1)
+		inverse.Quo(inverse, exp)
+
+		return Decimal{fl: z.Set(fn(exp, inverse))}
+	}, x)
+}
+
+// reduceHalfPi returns r and k mod 4 such that x = k π/2 + r, with |r| <= π/4 and r
+// accurate to prec bits.
+func reduceHalfPi(x *big.Float, prec uint) (*big.Float, int) {
+	exponent := max(x.MantExp(nil), 0)
+	ep := prec + uint(exponent)
+
+	for {
+		halfPi := piConstant.get(ep)
+		halfPi.SetMantExp(halfPi, -1)
+
+		quotient := newFloatPrec(ep).Quo(x, halfPi)
+		k := roundFloat(quotient)
+		if k.Sign() == 0 {
+			return newFloatPrec(prec).Set(x), 0
+		}
+
+		r := newFloatPrec(ep).SetInt(k)
+		r.Sub(x, r.Mul(r, halfPi))
+
+		// The absolute error of r is about 2^(exponent - ep); when r is small, retry with
+		// enough precision to keep its relative error within prec bits.
+		if lost := exponent - r.MantExp(nil); r.Sign() != 0 && lost > int(ep-prec) {
+			ep = prec + uint(lost) + 16
+			continue
+		}
+
+		quadrant := new(big.Int).Mod(k, big.NewInt(4))
+		return r.SetPrec(prec), int(quadrant.Int64())
+	}
+}
+
+// roundFloat returns x rounded to the nearest integer, with ties away from zero.
+func roundFloat(x *big.Float) *big.Int {
+	half := new(big.Float).SetPrec(x.Prec() + 1).SetFloat64(0.5)
+	if x.Sign() < 0 {
+		half.Neg(half)
+	}
+
+	rounded, _ := half.Add(half, x).Int(nil)
+	return rounded
+}
+
+// sinCosSeries returns the sine and cosine, for |r| <= π/4, to prec bits from their
+// Taylor series.
+func sinCosSeries(r *big.Float, prec uint) (*big.Float, *big.Float) {
+	square := newFloatPrec(prec).Mul(r, r)
+
+	sin := newFloatPrec(prec).Set(r)
+	term := newFloatPrec(prec).Set(r)
+	for n := int64(2); ; n += 2 {
+		term.Mul(term, square)
+		term.Quo(term, newFloatPrec(prec).SetInt64(-n*(n+1)))
+
+		if negligible(term, sin, prec) {
+			break
+		}
+
+		sin.Add(sin, term)
+	}
+
+	cos := newFloatPrec(prec).SetInt64(1)
+	term.SetInt64(1)
+	for n := int64(1); ; n += 2 {
+		term.Mul(term, square)
+		term.Quo(term, newFloatPrec(prec).SetInt64(-n*(n+1)))
+
+		if negligible(term, cos, prec) {
+			break
+		}
+
+		cos.Add(cos, term)
+	}
+
+	return sin, cos
+}
+
+// atanFloat returns the arctangent of finite x to prec bits.
+func atanFloat(x *big.Float, prec uint) *big.Float {
+	if x.Sign() == 0 {
+		return newFloatPrec(prec)
+	}
+
+	wp := prec + guardPrecision
+	z := newFloatPrec(wp).Abs(x)
+	one := newFloatPrec(wp).SetInt64(1)
+
+	// atan z = π/2 - atan(1/z), and atan z = 2 atan(z / (1 + √(1 + z²))) halves the
+	// argument until the series converges quickly.
+	inverted := z.Cmp(one) > 0
+	if inverted {
+		z.Quo(one, z)
+	}
+
+	steps := reductionSteps(prec)
+	for i := uint(0); i < steps; i++ {
+		denominator := newFloatPrec(wp).Mul(z, z)
+		denominator.Add(denominator, one)
+		denominator.Sqrt(denominator)
+		z.Quo(z, denominator.Add(denominator, one))
+	}
+
+	result := atanSeries(z, wp)
+	result.SetMantExp(result, int(steps))
+
+	if inverted {
+		halfPi := piConstant.get(wp)
+		result.Sub(halfPi.SetMantExp(halfPi, -1), result)
+	}
+
+	if x.Sign() < 0 {
+		result.Neg(result)
+	}
+
+	return result.SetPrec(prec)
+}
+
+// atanSeries returns atan(z) = z - z^3/3 + z^5/5 - ..., for |z| < 1, to prec bits.
+func atanSeries(z *big.Float, prec uint) *big.Float {
+	sum := newFloatPrec(prec).Set(z)
+	power := newFloatPrec(prec).Set(z)
+	square := newFloatPrec(prec).Mul(z, z)
+	square.Neg(square)
+
+	for n := int64(3); ; n += 2 {
+		power.Mul(power, square)
+		term := newFloatPrec(prec).Quo(power, newFloatPrec(prec).SetInt64(n))
+
+		if negligible(term, sum, prec) {
+			return sum
+		}
+
+		sum.Add(sum, term)
+	}
+}
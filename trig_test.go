@@ -0,0 +1,253 @@
+package big
+
+import (
+	"errors"
+	mathbig "math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const piDigits = "3.14159265358979323846264338327950288419716939937510582097494459230781640628620899862803482534211706798214808651"
+
+func TestPi(t *testing.T) {
+	assertDigits(t, piDigits, Pi(0), 75)
+	assertDigits(t, piDigits, Pi(360), 105)
+	assert.EqualValues(t, 360, Pi(360).value().Prec())
+	assert.EqualValues(t, 256, Pi(0).value().Prec())
+	assert.EqualValues(t, "3.1416", Pi(64).FormattedString(4))
+}
+
+func TestDecimal_Sin(t *testing.T) {
+	assertDigits(t, "0.8414709848078965066525023216302989996225630607983710656727517099919104043912396689486397435430526958543490379079", ONE.Sin(), 75)
+	assertDigits(t, "-0.8414709848078965066525023216302989996225630607983710656727517099919104043912396689486397435430526958543490379079", ONE.Neg().Sin(), 75)
+	assertDigits(t, "0.5", Pi(0).Div(NewFromInt(6)).Sin(), 70)
+	assertDigits(t, "-0.8522008497671888017727058937530293682618", NewFromString("1e22").Sin(), 40)
+
+	t.Run("near multiples of pi", func(t *testing.T) {
+		pi := Pi(256)
+		residual := Pi(512).Sub(pi)
+
+		assertDigits(t, residual.value().Text('g', 30), pi.Sin(), 30)
+		assertDigits(t, residual.Mul(NewFromInt(-2)).value().Text('g', 30), pi.Mul(NewFromInt(2)).Sin(), 30)
+	})
+
+	validateEqExamples(t,
+		equalExample{
+			value:    ZERO.Sin(),
+			expected: "0",
+		},
+		equalExample{
+			value:    NaN.Sin(),
+			expected: "NaN",
+		},
+		equalExample{
+			value:    PosInf().Sin(),
+			expected: "NaN",
+		},
+	)
+
+	assert.True(t, errors.Is(NegInf().Sin().NaNReason(), ErrDomain))
+}
+
+func TestDecimal_Cos(t *testing.T) {
+	assertDigits(t, "0.5403023058681397174009366074429766037323104206179222276700972553811003947744717645179518560871830893435717311600", ONE.Cos(), 75)
+	assertDigits(t, "0.5", Pi(0).Div(NewFromInt(3)).Cos(), 70)
+	assertDigits(t, "-1", Pi(0).Cos(), 70)
+
+	validateEqExamples(t,
+		equalExample{
+			value:    ZERO.Cos(),
+			expected: "1",
+		},
+		equalExample{
+			value:    PosInf().Cos(),
+			expected: "NaN",
+		},
+	)
+}
+
+func TestDecimal_Tan(t *testing.T) {
+	assertDigits(t, "1.5574077246549022305069748074583601730872507723815200383839466056988613971517272895550999652022429838046", ONE.Tan(), 75)
+	assertDigits(t, "1", Pi(0).Div(NewFromInt(4)).Tan(), 70)
+	assertDigits(t, "-1", Pi(0).Mul(NewFromInt(3)).Div(NewFromInt(4)).Tan(), 70)
+	assert.True(t, PosInf().Tan().NaN())
+}
+
+func TestDecimal_Asin(t *testing.T) {
+	assertDigits(t, "0.523598775598298873077107230546583814032861566562517636829157432051302734381034833104672471", NewFromString("0.5").Asin(), 75)
+	assertDigits(t, "-1.570796326794896619231321691639751442098584699687552910487472296153908203143104499314017413", ONE.Neg().Asin(), 75)
+
+	validateEqExamples(t,
+		equalExample{
+			value:    ZERO.Asin(),
+			expected: "0",
+		},
+		equalExample{
+			value:    NewFromString("1.5").Asin(),
+			expected: "NaN",
+		},
+	)
+
+	assert.True(t, errors.Is(NewFromInt(-2).Asin().NaNReason(), ErrDomain))
+}
+
+func TestDecimal_Acos(t *testing.T) {
+	assertDigits(t, "1.04719755119659774615421446109316762806572313312503527365831486410260546876206966620934494", NewFromString("0.5").Acos(), 75)
+	assertDigits(t, piDigits, ONE.Neg().Acos(), 75)
+	assertDigits(t, "1.4142135623730950488016887242096980785696718753769480731766797379907324784621070388503875343276415727e-20", NewFromString("0.9999999999999999999999999999999999999999").Acos(), 30)
+
+	validateEqExamples(t,
+		equalExample{
+			value:    ONE.Acos(),
+			expected: "0",
+		},
+		equalExample{
+			value:    NewFromString("-1.5").Acos(),
+			expected: "NaN",
+		},
+	)
+}
+
+func TestDecimal_Atan(t *testing.T) {
+	assertDigits(t, "0.785398163397448309615660845819875721049292349843776455243736148076954101571552249657008706", ONE.Atan(), 75)
+	assertDigits(t, "-1.4711276743037345918528755717617308518553063771832382624719635193438804556955538", NewFromInt(-10).Atan(), 75)
+	assertDigits(t, "1.570796326794896619231321691639751442098584699687552910487472296153908203143104499314017413", PosInf().Atan(), 75)
+	assertDigits(t, "1e-50", NewFromString("1e-50").Atan(), 70)
+
+	validateEqExamples(t,
+		equalExample{
+			value:    ZERO.Atan(),
+			expected: "0",
+		},
+		equalExample{
+			value:    NaN.Atan(),
+			expected: "NaN",
+		},
+	)
+}
+
+func TestDecimal_Atan2(t *testing.T) {
+	quarterPi := "0.785398163397448309615660845819875721049292349843776455243736148076954101571552249657008706"
+	threeQuarterPi := "2.35619449019234492884698253745962716314787704953132936573120844423086230471465674897102612"
+	halfPi := "1.570796326794896619231321691639751442098584699687552910487472296153908203143104499314017413"
+
+	examples := []struct {
+		y, x     Decimal
+		expected string
+	}{
+		{ONE, ONE, quarterPi},
+		{ONE, ONE.Neg(), threeQuarterPi},
+		{ONE.Neg(), ONE.Neg(), "-" + threeQuarterPi},
+		{ONE.Neg(), ONE, "-" + quarterPi},
+		{ONE, ZERO, halfPi},
+		{ONE.Neg(), ZERO, "-" + halfPi},
+		{ZERO, ONE.Neg(), piDigits},
+		{ZERO.Neg(), ONE.Neg(), "-" + piDigits},
+		{ZERO, ZERO.Neg(), piDigits},
+		{PosInf(), PosInf(), quarterPi},
+		{PosInf(), NegInf(), threeQuarterPi},
+		{NegInf(), TEN, "-" + halfPi},
+		{TEN, NegInf(), piDigits},
+	}
+
+	for _, ex := range examples {
+		assertDigits(t, ex.expected, ex.y.Atan2(ex.x), 75)
+	}
+
+	validateEqExamples(t,
+		equalExample{
+			value:    ZERO.Atan2(ZERO),
+			expected: "0",
+		},
+		equalExample{
+			value:    ZERO.Neg().Atan2(ONE),
+			expected: "-0",
+		},
+		equalExample{
+			value:    TEN.Atan2(PosInf()),
+			expected: "0",
+		},
+		equalExample{
+			value:    NaN.Atan2(ONE),
+			expected: "NaN",
+		},
+		equalExample{
+			value:    ONE.Atan2(NaN),
+			expected: "NaN",
+		},
+	)
+}
+
+func TestDecimal_Hyperbolic(t *testing.T) {
+	assertDigits(t, "1.17520119364380145688238185059560081515571798133409587022956541301330756730432389560711746", ONE.Sinh(), 75)
+	assertDigits(t, "1.54308063481524377847790562075706168260152911236586370473740221471076906304922369896426472", ONE.Cosh(), 75)
+	assertDigits(t, "0.761594155955764888119458282604793590412768597257936551596810500121953244576638483458947524", ONE.Tanh(), 75)
+	assertDigits(t, "-0.761594155955764888119458282604793590412768597257936551596810500121953244576638483458947524", ONE.Neg().Tanh(), 75)
+	assertDigits(t, "1.0000000000000000000000000000000000000000000000000000000000001666666666666666666666666666666666666666666666e-30", NewFromString("1e-30").Sinh(), 75)
+	assertDigits(t, "9.999999999999999999999999999999999999999999999999999999999996666666666666666666666666666666666666666666666e-31", NewFromString("1e-30").Tanh(), 75)
+
+	validateEqExamples(t,
+		equalExample{
+			value:    ZERO.Sinh(),
+			expected: "0",
+		},
+		equalExample{
+			value:    ZERO.Cosh(),
+			expected: "1",
+		},
+		equalExample{
+			value:    NegInf().Sinh(),
+			expected: "-Inf",
+		},
+		equalExample{
+			value:    NegInf().Cosh(),
+			expected: "+Inf",
+		},
+		equalExample{
+			value:    NegInf().Tanh(),
+			expected: "-1",
+		},
+		equalExample{
+			value:    NewFromString("1e10").Tanh(),
+			expected: "1",
+		},
+		equalExample{
+			value:    NewFromString("1e10").Sinh(),
+			expected: "+Inf",
+		},
+		equalExample{
+			value:    NewFromString("-1e10").Sinh(),
+			expected: "-Inf",
+		},
+		equalExample{
+			value:    NaN.Tanh(),
+			expected: "NaN",
+		},
+	)
+
+	t.Run("tiny arguments", func(t *testing.T) {
+		tiny := NewFromString("1e-100000")
+
+		assert.True(t, tiny.Sinh().EQ(tiny))
+		assert.True(t, tiny.Neg().Tanh().EQ(tiny.Neg()))
+		assert.True(t, tiny.Cosh().EQ(ONE))
+		assertDigits(t, "1.0000000000000000000000000000000000000000000000000000000000000000000000000e-40", NewFromString("1e-40").Sinh(), 75)
+		assertDigits(t, "1", NewFromString("1e-40").Cosh(), 75)
+		assert.EqualValues(t, "-0", ZERO.Neg().Tanh().String())
+		assert.EqualValues(t, "-0", ZERO.Neg().Sinh().String())
+		assert.EqualValues(t, "1", ZERO.Neg().Cosh().String())
+	})
+}
+
+func TestContext_Trig(t *testing.T) {
+	ctx := NewContextDigits(30, mathbig.ToNearestEven)
+
+	for _, d := range []Decimal{
+		ctx.Sin(ONE), ctx.Cos(ONE), ctx.Tan(ONE),
+		ctx.Asin(ONE.Div(TEN)), ctx.Acos(ONE.Div(TEN)), ctx.Atan(ONE),
+		ctx.Atan2(ONE, TEN), ctx.Sinh(ONE), ctx.Cosh(ONE), ctx.Tanh(ONE),
+	} {
+		assert.EqualValues(t, 100, d.value().Prec())
+	}
+}
@@ -0,0 +1,218 @@
+package big
+
+import "math/big"
+
+// Window holds the last N decimals pushed to it, and keeps their sum, weighted sum,
+// minimum and maximum up to date so that Push and every statistic take constant time.
+// The zero value is not usable; create a Window with NewWindow.
+//
+// Sum and the weighted sum are exact, so the simple and weighted moving averages do not
+// drift however many values pass through the window. A NaN in the window makes every
+// statistic that NaN until it is pushed out, and like Mean and MaxSlice, the statistics
+// of an empty Window are 0. A Window is not safe for concurrent use.
+type Window struct {
+	values   []Decimal
+	pushed   int64
+	count    int
+	sum      *big.Float
+	weighted *big.Float
+	min      []windowEntry
+	max      []windowEntry
+	nanSeq   int64
+	posInf   int
+	negInf   int
+}
+
+type windowEntry struct {
+	seq   int64
+	value Decimal
+}
+
+// NewWindow creates a new Window holding the last size decimals pushed to it. It panics
+// if size is less than 1.
+func NewWindow(size int) *Window {
+	if size < 1 {
+		panic("big: NewWindow: size must be at least 1")
+	}
+
+	return &Window{
+		values:   make([]Decimal, size),
+		sum:      newFloat(minPrecision),
+		weighted: newFloat(minPrecision),
+		nanSeq:   -1,
+	}
+}
+
+// Push adds a value to the Window, pushing out the oldest value if the Window is full.
+func (w *Window) Push(value Decimal) {
+	size := len(w.values)
+	slot := int(w.pushed % int64(size))
+
+	var evicted *Decimal
+	if w.count == size {
+		evicted = &w.values[slot]
+	} else {
+		w.count++
+	}
+
+	// Every value keeps its weight of one in the sum, and its weight in the weighted sum
+	// falls by one on each push once the window is full, which drops the evicted value
+	// and leaves the newest value with weight count.
+	newest := w.finite(value)
+	weight := new(big.Float).SetInt64(int64(w.count))
+	weighted := []*big.Float{w.weighted, new(big.Float).SetPrec(newest.MinPrec()+64).Mul(newest, weight)}
+	sum := []*big.Float{w.sum, newest}
+
+	if evicted != nil {
+		weighted = append(weighted, new(big.Float).Neg(w.sum))
+		sum = append(sum, new(big.Float).Neg(w.finite(*evicted)))
+		w.countInf(*evicted, -1)
+	}
+
+	w.weighted, w.sum = exactSum(weighted), exactSum(sum)
+	w.values[slot] = value
+	w.countInf(value, 1)
+
+	if value.NaN() {
+		w.nanSeq = w.pushed
+	}
+
+	w.pushed++
+	oldest := w.pushed - int64(w.count)
+
+	w.min = pushMonotonic(w.min, oldest, windowEntry{seq: w.pushed - 1, value: value}, 1)
+	w.max = pushMonotonic(w.max, oldest, windowEntry{seq: w.pushed - 1, value: value}, -1)
+}
+
+// Len returns the number of values in the Window, which is at most its size.
+func (w *Window) Len() int {
+	return w.count
+}
+
+// Size returns the number of values the Window holds when it is full.
+func (w *Window) Size() int {
+	return len(w.values)
+}
+
+// Full returns true if the Window holds as many values as its size.
+func (w *Window) Full() bool {
+	return w.count == len(w.values)
+}
+
+// Values returns the values in the Window, from the oldest to the newest.
+func (w *Window) Values() []Decimal {
+	values := make([]Decimal, w.count)
+	for i := range values {
+		values[i] = w.values[int((w.pushed-int64(w.count)+int64(i))%int64(len(w.values)))]
+	}
+
+	return values
+}
+
+// Sum returns the exact sum of the values in the Window.
+func (w *Window) Sum() Decimal {
+	return w.average("Sum", w.sum, 1)
+}
+
+// Mean returns the arithmetic mean of the values in the Window, their simple moving
+// average.
+func (w *Window) Mean() Decimal {
+	return w.average("Mean", w.sum, int64(w.count))
+}
+
+// WeightedMean returns the linearly weighted moving average of the values in the Window,
+// in which the newest value has weight n, the one before it n-1, and the oldest 1.
+func (w *Window) WeightedMean() Decimal {
+	n := int64(w.count)
+	return w.average("WeightedMean", w.weighted, n*(n+1)/2)
+}
+
+// Min returns the smallest value in the Window.
+func (w *Window) Min() Decimal {
+	return w.extreme(w.min)
+}
+
+// Max returns the largest value in the Window.
+func (w *Window) Max() Decimal {
+	return w.extreme(w.max)
+}
+
+// average returns total divided by divisor, or the infinity or NaN the infinities in the
+// Window sum to.
+func (w *Window) average(op string, total *big.Float, divisor int64) Decimal {
+	if nan, ok := w.nan(); ok {
+		return nan
+	} else if w.count == 0 {
+		return zeroDecimal()
+	}
+
+	switch {
+	case w.posInf > 0 && w.negInf > 0:
+		return nanDecimal(ErrIndeterminate, op, PosInf(), NegInf())
+	case w.posInf > 0:
+		return PosInf()
+	case w.negInf > 0:
+		return NegInf()
+	case divisor == 1:
+		return Decimal{fl: total}
+	}
+
+	return DefaultContext.Div(Decimal{fl: total}, NewFromInt64(divisor))
+}
+
+func (w *Window) extreme(deque []windowEntry) Decimal {
+	if nan, ok := w.nan(); ok {
+		return nan
+	} else if w.count == 0 {
+		return zeroDecimal()
+	}
+
+	return deque[0].value
+}
+
+// nan returns the newest NaN in the Window, if there is one.
+func (w *Window) nan() (Decimal, bool) {
+	if w.nanSeq < w.pushed-int64(w.count) {
+		return Decimal{}, false
+	}
+
+	return w.values[int(w.nanSeq%int64(len(w.values)))], true
+}
+
+// finite returns the value of a finite decimal, and zero for NaN and the infinities,
+// which are counted separately.
+func (w *Window) finite(value Decimal) *big.Float {
+	if !value.finite() {
+		return &flZero
+	}
+
+	return value.value()
+}
+
+func (w *Window) countInf(value Decimal, delta int) {
+	if value.IsInf(1) {
+		w.posInf += delta
+	} else if value.IsInf(-1) {
+		w.negInf += delta
+	}
+}
+
+// pushMonotonic drops the entries of a monotonic deque that are older than oldest or can
+// no longer be its front once entry is pushed, and pushes entry. Entries ordered by
+// Cmp(entry) == order are kept, so order 1 keeps the minimum at the front and -1 the
+// maximum.
+func pushMonotonic(deque []windowEntry, oldest int64, entry windowEntry, order int) []windowEntry {
+	for len(deque) > 0 && deque[0].seq < oldest {
+		deque = deque[1:]
+	}
+
+	if entry.value.NaN() {
+		return deque
+	}
+
+	for len(deque) > 0 && deque[len(deque)-1].value.Cmp(entry.value) != -order {
+		deque = deque[:len(deque)-1]
+	}
+
+	return append(deque, entry)
+}
@@ -0,0 +1,109 @@
+package big
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWindow(t *testing.T) {
+	w := NewWindow(3)
+
+	assert.EqualValues(t, 3, w.Size())
+	assert.EqualValues(t, 0, w.Len())
+	assert.False(t, w.Full())
+	assert.True(t, w.Sum().IsZero())
+	assert.True(t, w.Mean().IsZero())
+	assert.True(t, w.Min().IsZero())
+
+	for _, value := range decimalsFromInts(4, 8, 6, 2) {
+		w.Push(value)
+	}
+
+	assert.True(t, w.Full())
+	assert.EqualValues(t, []string{"8", "6", "2"}, decimalStrings(w.Values()))
+
+	validateEqExamples(t,
+		equalExample{
+			value:    w.Sum(),
+			expected: "16",
+		},
+		equalExample{
+			value:    w.Min(),
+			expected: "2",
+		},
+		equalExample{
+			value:    w.Max(),
+			expected: "8",
+		},
+	)
+
+	assertDigits(t, "5.3333333333333333333333333333333333333333333333333333333333333333333333", w.Mean(), 70)
+	assertDigits(t, "4.3333333333333333333333333333333333333333333333333333333333333333333333", w.WeightedMean(), 70)
+
+	assert.Panics(t, func() { NewWindow(0) })
+}
+
+func TestWindow_MatchesSlice(t *testing.T) {
+	values := decimalsFromStrings("0.1", "0.2", "-3.75", "1e-30", "12345.6789", "0.3", "0.3", "-0.1", "1e20", "7", "-2.5", "0.7", "0.2")
+
+	for size := 1; size <= 5; size++ {
+		w := NewWindow(size)
+
+		for i, value := range values {
+			w.Push(value)
+
+			window := values[max(0, i+1-size) : i+1]
+			weights := make([]Decimal, len(window))
+			for j := range weights {
+				weights[j] = NewFromInt(j + 1)
+			}
+
+			assert.True(t, w.Sum().EQ(Sum(window...)), "size %d at %d: %s != %s", size, i, w.Sum(), Sum(window...))
+			assert.True(t, w.Min().EQ(MinSlice(window...)), "size %d at %d", size, i)
+			assert.True(t, w.Max().EQ(MaxSlice(window...)), "size %d at %d", size, i)
+			assertDigits(t, Mean(window...).String(), w.Mean(), 70)
+			assertDigits(t, WeightedMean(window, weights).String(), w.WeightedMean(), 70)
+		}
+
+		assert.LessOrEqual(t, w.Sum().Precision(), uint(512))
+	}
+}
+
+func TestWindow_NaNAndInf(t *testing.T) {
+	w := NewWindow(2)
+	poisoned := ZERO.Div(ZERO)
+
+	w.Push(ONE)
+	w.Push(poisoned)
+
+	assert.True(t, errors.Is(w.Sum().NaNReason(), ErrDivisionByZero))
+	assert.True(t, w.Min().NaN())
+	assert.True(t, w.WeightedMean().NaN())
+
+	w.Push(PosInf())
+	assert.True(t, w.Max().NaN())
+
+	w.Push(TEN)
+	assert.True(t, w.Sum().IsInf(1))
+	assert.True(t, w.Mean().IsInf(1))
+	assert.True(t, w.Max().IsInf(1))
+	assert.EqualValues(t, "10", w.Min().String())
+
+	w.Push(NegInf())
+	assert.True(t, w.Sum().IsInf(-1))
+	assert.True(t, w.Min().IsInf(-1))
+
+	w = NewWindow(2)
+	w.Push(PosInf())
+	w.Push(NegInf())
+	assert.True(t, errors.Is(w.Mean().NaNReason(), ErrIndeterminate))
+
+	w.Push(TEN)
+	w.Push(ONE)
+	assert.EqualValues(t, "11", w.Sum().String())
+	assert.EqualValues(t, "5.5", w.Mean().String())
+	assert.EqualValues(t, "1", w.Min().String())
+	assert.EqualValues(t, "10", w.Max().String())
+}